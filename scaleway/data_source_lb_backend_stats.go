@@ -0,0 +1,112 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayLbBackendStats() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayLbBackendStatsRead,
+		Schema: map[string]*schema.Schema{
+			"backend_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the backend",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"lb_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The load-balancer ID the backend is attached to",
+			},
+			"backend_servers_stats": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of backend servers statistics",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"instance_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the load-balancer underlying instance",
+						},
+						"ip": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IP address of the backend server",
+						},
+						"server_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Server operational state (stopped/starting/running/stopping)",
+						},
+						"server_state_changed_at": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Date at which the server operational state last changed",
+						},
+						"last_health_check_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last health check status (unknown/neutral/failed/passed/condpass)",
+						},
+					},
+				},
+			},
+			"zone": zoneSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayLbBackendStatsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, zone, err := lbAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backendZone, backendID, err := parseZonedID(datasourceNewZonedID(d.Get("backend_id"), zone))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backend, err := lbAPI.GetBackend(&lbSDK.ZonedAPIGetBackendRequest{
+		Zone:      backendZone,
+		BackendID: backendID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := lbAPI.ListBackendStats(&lbSDK.ZonedAPIListBackendStatsRequest{
+		Zone:      backendZone,
+		LBID:      backend.LB.ID,
+		BackendID: &backend.ID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	stats := []interface{}(nil)
+	for _, serverStats := range res.BackendServersStats {
+		stats = append(stats, map[string]interface{}{
+			"instance_id":              serverStats.InstanceID,
+			"ip":                       serverStats.IP,
+			"server_state":             serverStats.ServerState.String(),
+			"server_state_changed_at":  flattenTime(serverStats.ServerStateChangedAt),
+			"last_health_check_status": serverStats.LastHealthCheckStatus.String(),
+		})
+	}
+
+	d.SetId(newZonedIDString(backendZone, backend.ID))
+	_ = d.Set("backend_id", newZonedIDString(backendZone, backend.ID))
+	_ = d.Set("lb_id", newZonedIDString(backendZone, backend.LB.ID))
+	_ = d.Set("zone", backendZone)
+	_ = d.Set("backend_servers_stats", stats)
+
+	return nil
+}
@@ -0,0 +1,55 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceLbBackendStats_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayLbDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_lb_ip main {}
+
+					resource scaleway_lb main {
+						ip_id = scaleway_lb_ip.main.id
+						name  = "data-test-lb-backend-stats"
+						type  = "LB-S"
+					}
+
+					resource "scaleway_lb_backend" "main" {
+						lb_id            = scaleway_lb.main.id
+						name             = "backend01"
+						forward_protocol = "http"
+						forward_port     = "80"
+						server_ips       = ["10.0.0.10"]
+					}
+
+					data "scaleway_lb_backend_stats" "main" {
+						backend_id = scaleway_lb_backend.main.id
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(
+						"data.scaleway_lb_backend_stats.main", "lb_id",
+						"scaleway_lb.main", "id"),
+					resource.TestCheckResourceAttrPair(
+						"data.scaleway_lb_backend_stats.main", "backend_id",
+						"scaleway_lb_backend.main", "id"),
+					resource.TestCheckResourceAttrSet("data.scaleway_lb_backend_stats.main", "backend_servers_stats.0.ip"),
+					resource.TestCheckResourceAttrSet("data.scaleway_lb_backend_stats.main", "backend_servers_stats.0.last_health_check_status"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,366 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayLbConfig() *schema.Resource {
+	// ACL match and action blocks are the same as the ones listed by scaleway_lb_acls
+	aclSchema := dataSourceScalewayLbACLs().Schema["acls"].Elem.(*schema.Resource).Schema
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayLbConfigRead,
+		Schema: map[string]*schema.Schema{
+			"lb_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the load-balancer",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the load-balancer",
+			},
+			"ips": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IPs attached to the load-balancer",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"ip_address": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"frontends": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The frontends of the load-balancer",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backend_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"inbound_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"timeout_client": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"certificate_ids": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"enable_http3": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"backends": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The backends of the load-balancer",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"forward_protocol": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"forward_port": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"server_ips": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+					},
+				},
+			},
+			"acls": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The ACLs of the load-balancer frontends",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frontend_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"index": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"match":  aclSchema["match"],
+						"action": aclSchema["action"],
+					},
+				},
+			},
+			"routes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The routes of the load-balancer frontends",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"frontend_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"backend_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"match_sni": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"match_host_header": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The certificates of the load-balancer",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"common_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subject_alternative_name": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Schema{
+								Type: schema.TypeString,
+							},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"zone":            zoneSchema(),
+			"organization_id": organizationIDSchema(),
+			"project_id":      projectIDSchema(),
+		},
+	}
+}
+
+//gocyclo:ignore
+func dataSourceScalewayLbConfigRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	lbAPI, zone, err := lbAPIWithZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone, lbID, err := parseZonedID(datasourceNewZonedID(d.Get("lb_id"), zone))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lb, err := lbAPI.GetLB(&lbSDK.ZonedAPIGetLBRequest{
+		Zone: zone,
+		LBID: lbID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ips := []interface{}(nil)
+	for _, ip := range lb.IP {
+		ips = append(ips, map[string]interface{}{
+			"id":         newZonedIDString(zone, ip.ID),
+			"ip_address": ip.IPAddress,
+		})
+	}
+
+	frontendsRes, err := lbAPI.ListFrontends(&lbSDK.ZonedAPIListFrontendsRequest{
+		Zone: zone,
+		LBID: lbID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	frontends := []interface{}(nil)
+	acls := []interface{}(nil)
+	routes := []interface{}(nil)
+	for _, frontend := range frontendsRes.Frontends {
+		certificateIDs := []string(nil)
+		for _, certificateID := range frontend.CertificateIDs {
+			certificateIDs = append(certificateIDs, newZonedIDString(zone, certificateID))
+		}
+		frontends = append(frontends, map[string]interface{}{
+			"id":              newZonedIDString(zone, frontend.ID),
+			"name":            frontend.Name,
+			"backend_id":      newZonedIDString(zone, frontend.Backend.ID),
+			"inbound_port":    frontend.InboundPort,
+			"timeout_client":  flattenDuration(frontend.TimeoutClient),
+			"certificate_ids": certificateIDs,
+			"enable_http3":    frontend.EnableHTTP3,
+		})
+
+		aclsRes, err := lbAPI.ListACLs(&lbSDK.ZonedAPIListACLsRequest{
+			Zone:       zone,
+			FrontendID: frontend.ID,
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, acl := range aclsRes.ACLs {
+			acls = append(acls, map[string]interface{}{
+				"id":          newZonedIDString(zone, acl.ID),
+				"name":        acl.Name,
+				"frontend_id": newZonedIDString(zone, frontend.ID),
+				"index":       acl.Index,
+				"description": acl.Description,
+				"match":       flattenLbACLMatch(acl.Match),
+				"action":      flattenLbACLAction(acl.Action),
+			})
+		}
+
+		routesRes, err := lbAPI.ListRoutes(&lbSDK.ZonedAPIListRoutesRequest{
+			Zone:       zone,
+			FrontendID: scw.StringPtr(frontend.ID),
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		for _, route := range routesRes.Routes {
+			routes = append(routes, map[string]interface{}{
+				"id":                newZonedIDString(zone, route.ID),
+				"frontend_id":       newZonedIDString(zone, route.FrontendID),
+				"backend_id":        newZonedIDString(zone, route.BackendID),
+				"match_sni":         flattenStringPtr(route.Match.Sni),
+				"match_host_header": flattenStringPtr(route.Match.HostHeader),
+			})
+		}
+	}
+
+	backendsRes, err := lbAPI.ListBackends(&lbSDK.ZonedAPIListBackendsRequest{
+		Zone: zone,
+		LBID: lbID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	backends := []interface{}(nil)
+	for _, backend := range backendsRes.Backends {
+		backends = append(backends, map[string]interface{}{
+			"id":               newZonedIDString(zone, backend.ID),
+			"name":             backend.Name,
+			"forward_protocol": flattenLbProtocol(backend.ForwardProtocol),
+			"forward_port":     backend.ForwardPort,
+			"server_ips":       backend.Pool,
+		})
+	}
+
+	certificatesRes, err := lbAPI.ListCertificates(&lbSDK.ZonedAPIListCertificatesRequest{
+		Zone: zone,
+		LBID: lbID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	certificates := []interface{}(nil)
+	for _, certificate := range certificatesRes.Certificates {
+		certificates = append(certificates, map[string]interface{}{
+			"id":                       newZonedIDString(zone, certificate.ID),
+			"name":                     certificate.Name,
+			"type":                     certificate.Type.String(),
+			"common_name":              certificate.CommonName,
+			"subject_alternative_name": certificate.SubjectAlternativeName,
+			"status":                   certificate.Status.String(),
+		})
+	}
+
+	d.SetId(newZonedIDString(zone, lb.ID))
+	_ = d.Set("lb_id", newZonedIDString(zone, lb.ID))
+	_ = d.Set("name", lb.Name)
+	_ = d.Set("zone", zone)
+	_ = d.Set("organization_id", lb.OrganizationID)
+	_ = d.Set("project_id", lb.ProjectID)
+	_ = d.Set("ips", ips)
+	_ = d.Set("frontends", frontends)
+	_ = d.Set("backends", backends)
+	_ = d.Set("acls", acls)
+	_ = d.Set("routes", routes)
+	_ = d.Set("certificates", certificates)
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceLbConfig_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayLbDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_lb_ip ip01 {}
+					resource scaleway_lb lb01 {
+						ip_id = scaleway_lb_ip.ip01.id
+						name = "test-lb-config"
+						type = "lb-s"
+					}
+					resource scaleway_lb_backend bkd01 {
+						lb_id = scaleway_lb.lb01.id
+						forward_protocol = "http"
+						forward_port = 80
+						proxy_protocol = "none"
+					}
+					resource scaleway_lb_frontend frt01 {
+						lb_id = scaleway_lb.lb01.id
+						backend_id = scaleway_lb_backend.bkd01.id
+						inbound_port = 80
+						acl {
+							name  = "tf-acl-config"
+							action {
+								type = "allow"
+							}
+							match {
+								ip_subnet = ["192.168.0.1"]
+							}
+						}
+					}
+					resource scaleway_lb_route rt01 {
+						frontend_id = scaleway_lb_frontend.frt01.id
+						backend_id = scaleway_lb_backend.bkd01.id
+						match_host_header = "example.org"
+					}
+
+					data scaleway_lb_config main {
+						lb_id = scaleway_lb.lb01.id
+						depends_on = [scaleway_lb_frontend.frt01, scaleway_lb_route.rt01]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_lb_config.main", "name", "test-lb-config"),
+					resource.TestCheckResourceAttrPair("data.scaleway_lb_config.main", "ips.0.id", "scaleway_lb_ip.ip01", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_lb_config.main", "frontends.0.id", "scaleway_lb_frontend.frt01", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_lb_config.main", "frontends.0.backend_id", "scaleway_lb_backend.bkd01", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_lb_config.main", "backends.0.id", "scaleway_lb_backend.bkd01", "id"),
+					resource.TestCheckResourceAttrPair("data.scaleway_lb_config.main", "routes.0.id", "scaleway_lb_route.rt01", "id"),
+					resource.TestCheckResourceAttr("data.scaleway_lb_config.main", "acls.0.name", "tf-acl-config"),
+					resource.TestCheckResourceAttr("data.scaleway_lb_config.main", "certificates.#", "0"),
+				),
+			},
+		},
+	})
+}
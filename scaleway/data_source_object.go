@@ -0,0 +1,258 @@
+package scaleway
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"mime"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+// defaultObjectMaxBodySize is the size above which the content of an object is not downloaded by default
+const defaultObjectMaxBodySize = 10 * 1024 * 1024
+
+func dataSourceScalewayObject() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayObjectRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key of the object",
+			},
+			"version_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Version of the object, defaults to the latest one",
+			},
+			"sse_customer_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				Description:  "Customer's encryption key (base64 encoded 256-bit key) the object is encrypted with",
+				ValidateFunc: validateObjectSSECustomerKey(),
+				StateFunc: func(i interface{}) string {
+					return objectSSECustomerKeyMD5(i.(string))
+				},
+			},
+			"max_body_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultObjectMaxBodySize,
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum size (in bytes) of an object whose content is downloaded, 0 to never download the content",
+			},
+			"body": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content of the object, only set for text content types",
+			},
+			"content_base64": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content of the object, base64 encoded",
+			},
+			"content_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Content type of the object",
+			},
+			"content_length": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the object (in bytes)",
+			},
+			"etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ETag of the object",
+			},
+			"last_modified": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modification date of the object (RFC 3339 format)",
+			},
+			"storage_class": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Scaleway Object Storage class of the object",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of object's metadata",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"tags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of object's tags",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Visibility of the object, public-read or private",
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayObjectRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	region, err := extractRegion(d, meta.(*Meta))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	objectRegionalID := newRegionalIDString(region, objectID(d.Get("bucket").(string), d.Get("key").(string)))
+	s3Client, region, key, bucket, err := s3ClientWithRegionAndNestedName(d, meta, objectRegionalID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	sseCustomerKey := objectSSECustomerKeyFromConfig(d)
+	obj, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+		Bucket:               scw.StringPtr(bucket),
+		Key:                  scw.StringPtr(key),
+		VersionId:            expandStringPtr(d.Get("version_id")),
+		SSECustomerAlgorithm: objectSSECustomerAlgorithm(sseCustomerKey),
+		SSECustomerKey:       sseCustomerKey,
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed getting object %s in bucket %s: %w", key, bucket, err))
+	}
+
+	var diags diag.Diagnostics
+	contentType := flattenStringPtr(obj.ContentType).(string)
+	contentLength := aws.Int64Value(obj.ContentLength)
+	maxBodySize := int64(d.Get("max_body_size").(int))
+
+	// The content is stored in the state, large objects are not downloaded
+	var content []byte
+	if maxBodySize > 0 && contentLength <= maxBodySize {
+		content, err = readObjectContent(ctx, s3Client, &s3.GetObjectInput{
+			Bucket:               scw.StringPtr(bucket),
+			Key:                  scw.StringPtr(key),
+			VersionId:            obj.VersionId,
+			SSECustomerAlgorithm: objectSSECustomerAlgorithm(sseCustomerKey),
+			SSECustomerKey:       sseCustomerKey,
+		}, maxBodySize)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed getting object %s in bucket %s: %w", key, bucket, err))
+		}
+	} else if maxBodySize > 0 {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("content of object %s is not downloaded", key),
+			Detail:   fmt.Sprintf("The object is %d bytes, above max_body_size (%d bytes). body and content_base64 are left empty.", contentLength, maxBodySize),
+		})
+	}
+
+	if objectContentTypeIsText(contentType) {
+		_ = d.Set("body", string(content))
+	} else {
+		_ = d.Set("body", "")
+	}
+	_ = d.Set("content_base64", base64.StdEncoding.EncodeToString(content))
+
+	tags, err := s3Client.GetObjectTaggingWithContext(ctx, &s3.GetObjectTaggingInput{
+		Bucket:    scw.StringPtr(bucket),
+		Key:       scw.StringPtr(key),
+		VersionId: obj.VersionId,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	acl, err := s3Client.GetObjectAclWithContext(ctx, &s3.GetObjectAclInput{
+		Bucket:    scw.StringPtr(bucket),
+		Key:       scw.StringPtr(key),
+		VersionId: obj.VersionId,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	metadata := make(map[string]*string, len(obj.Metadata))
+	for k, v := range obj.Metadata {
+		metadata[strings.ToLower(k)] = v
+	}
+
+	d.SetId(objectRegionalID)
+	_ = d.Set("version_id", flattenStringPtr(obj.VersionId))
+	_ = d.Set("content_type", contentType)
+	_ = d.Set("content_length", int(contentLength))
+	_ = d.Set("etag", strings.Trim(flattenStringPtr(obj.ETag).(string), `"`))
+	_ = d.Set("last_modified", flattenTime(obj.LastModified))
+	_ = d.Set("storage_class", flattenStringPtr(obj.StorageClass))
+	_ = d.Set("metadata", flattenMapStringStringPtr(metadata))
+	_ = d.Set("tags", flattenObjectBucketTags(tags.TagSet))
+	if objectIsPublic(acl) {
+		_ = d.Set("visibility", s3.ObjectCannedACLPublicRead)
+	} else {
+		_ = d.Set("visibility", s3.ObjectCannedACLPrivate)
+	}
+	_ = d.Set("region", region)
+
+	return diags
+}
+
+// readObjectContent downloads the content of an object, failing if it is larger than maxSize
+func readObjectContent(ctx context.Context, s3Client *s3.S3, input *s3.GetObjectInput, maxSize int64) ([]byte, error) {
+	obj, err := s3Client.GetObjectWithContext(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	defer obj.Body.Close()
+
+	// The object may have been replaced since it was checked
+	content, err := io.ReadAll(io.LimitReader(obj.Body, maxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(content)) > maxSize {
+		return nil, fmt.Errorf("object content is larger than max_body_size (%d bytes)", maxSize)
+	}
+
+	return content, nil
+}
+
+// objectContentTypeIsText returns whether an object of this content type can be exposed as a string
+func objectContentTypeIsText(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+
+	if strings.HasPrefix(mediaType, "text/") {
+		return true
+	}
+
+	switch mediaType {
+	case "application/json", "application/ld+json", "application/xml", "application/javascript",
+		"application/x-sh", "application/x-yaml", "application/yaml", "image/svg+xml":
+		return true
+	}
+
+	return strings.HasSuffix(mediaType, "+json") || strings.HasSuffix(mediaType, "+xml")
+}
@@ -0,0 +1,286 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal"
+)
+
+const maxObjectBucketUsageWorkers = 8
+
+func dataSourceScalewayObjectBucketUsage() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayObjectBucketUsageRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only count objects whose key starts with this prefix",
+			},
+			"sample_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Stop listing after this number of object versions, usage is then partial",
+			},
+			"total_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total size in bytes of all object versions",
+			},
+			"object_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects, noncurrent versions excluded",
+			},
+			"version_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of object versions, noncurrent versions included",
+			},
+			"storage_class": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Usage per storage class",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The storage class",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Total size in bytes of the object versions in this storage class",
+						},
+						"object_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of objects in this storage class",
+						},
+						"version_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of object versions in this storage class",
+						},
+					},
+				},
+			},
+			"sampled": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the listing stopped after sample_size object versions",
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+type objectStorageClassUsage struct {
+	Size         int64
+	ObjectCount  int64
+	VersionCount int64
+}
+
+// objectBucketUsage aggregates the usage of object versions listed concurrently
+type objectBucketUsage struct {
+	mu      sync.Mutex
+	classes map[string]*objectStorageClassUsage
+}
+
+func newObjectBucketUsage() *objectBucketUsage {
+	return &objectBucketUsage{
+		classes: make(map[string]*objectStorageClassUsage),
+	}
+}
+
+func (u *objectBucketUsage) add(versions []*s3.ObjectVersion) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	for _, version := range versions {
+		storageClass := aws.StringValue(version.StorageClass)
+		if storageClass == "" {
+			storageClass = s3.StorageClassStandard
+		}
+
+		usage, exists := u.classes[storageClass]
+		if !exists {
+			usage = &objectStorageClassUsage{}
+			u.classes[storageClass] = usage
+		}
+
+		usage.Size += aws.Int64Value(version.Size)
+		usage.VersionCount++
+		if aws.BoolValue(version.IsLatest) {
+			usage.ObjectCount++
+		}
+	}
+}
+
+func (u *objectBucketUsage) total() objectStorageClassUsage {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	total := objectStorageClassUsage{}
+	for _, usage := range u.classes {
+		total.Size += usage.Size
+		total.ObjectCount += usage.ObjectCount
+		total.VersionCount += usage.VersionCount
+	}
+
+	return total
+}
+
+func (u *objectBucketUsage) flatten() []map[string]interface{} {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	storageClasses := make([]string, 0, len(u.classes))
+	for storageClass := range u.classes {
+		storageClasses = append(storageClasses, storageClass)
+	}
+	sort.Strings(storageClasses)
+
+	flattened := make([]map[string]interface{}, 0, len(storageClasses))
+	for _, storageClass := range storageClasses {
+		usage := u.classes[storageClass]
+		flattened = append(flattened, map[string]interface{}{
+			"name":          storageClass,
+			"size":          int(usage.Size),
+			"object_count":  int(usage.ObjectCount),
+			"version_count": int(usage.VersionCount),
+		})
+	}
+
+	return flattened
+}
+
+func dataSourceScalewayObjectBucketUsageRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	sampleSize := int64(d.Get("sample_size").(int))
+
+	usage := newObjectBucketUsage()
+	sampled := false
+	if sampleSize > 0 {
+		sampled, err = listObjectBucketUsageSample(ctx, s3Client, bucket, prefix, sampleSize, usage)
+	} else {
+		err = listObjectBucketUsage(ctx, s3Client, bucket, prefix, usage)
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	total := usage.total()
+
+	d.SetId(newRegionalIDString(region, objectID(bucket, prefix)))
+	_ = d.Set("total_size", int(total.Size))
+	_ = d.Set("object_count", int(total.ObjectCount))
+	_ = d.Set("version_count", int(total.VersionCount))
+	_ = d.Set("storage_class", usage.flatten())
+	_ = d.Set("sampled", sampled)
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+// listObjectBucketUsage lists the first level under the prefix with a delimiter, each common prefix is then listed by a worker
+func listObjectBucketUsage(ctx context.Context, s3Client *s3.S3, bucket string, prefix string, usage *objectBucketUsage) error {
+	// Listing is stopped as soon as a prefix fails
+	listCtx, cancelList := context.WithCancel(ctx)
+	defer cancelList()
+
+	pool := internal.NewWorkerPool(maxObjectBucketUsageWorkers)
+	listErr := s3Client.ListObjectVersionsPagesWithContext(listCtx, &s3.ListObjectVersionsInput{
+		Bucket:    scw.StringPtr(bucket),
+		Prefix:    expandStringPtr(prefix),
+		Delimiter: scw.StringPtr("/"),
+	}, func(page *s3.ListObjectVersionsOutput, _ bool) bool {
+		usage.add(page.Versions)
+
+		for _, commonPrefix := range page.CommonPrefixes {
+			commonPrefix := aws.StringValue(commonPrefix.Prefix)
+			pool.AddTask(func() error {
+				if listCtx.Err() != nil {
+					return nil
+				}
+
+				err := s3Client.ListObjectVersionsPagesWithContext(listCtx, &s3.ListObjectVersionsInput{
+					Bucket: scw.StringPtr(bucket),
+					Prefix: scw.StringPtr(commonPrefix),
+				}, func(page *s3.ListObjectVersionsOutput, _ bool) bool {
+					usage.add(page.Versions)
+					return true
+				})
+				if err != nil {
+					cancelList()
+					return fmt.Errorf("failed listing object versions under prefix %s: %w", commonPrefix, err)
+				}
+
+				return nil
+			})
+		}
+
+		return true
+	})
+
+	errs := pool.CloseAndWait()
+	if len(errs) > 0 {
+		return multierror.Append(nil, errs...)
+	}
+	if listErr != nil {
+		return fmt.Errorf("failed listing object versions in bucket %s: %w", bucket, listErr)
+	}
+
+	return nil
+}
+
+// listObjectBucketUsageSample adds the first sampleSize object versions under the prefix, in key order, to the usage.
+// The listing is sequential so that the sample does not depend on the scheduling of concurrent listings.
+// It returns whether object versions were left out.
+func listObjectBucketUsageSample(ctx context.Context, s3Client *s3.S3, bucket string, prefix string, sampleSize int64, usage *objectBucketUsage) (bool, error) {
+	listed := int64(0)
+	sampled := false
+	err := s3Client.ListObjectVersionsPagesWithContext(ctx, &s3.ListObjectVersionsInput{
+		Bucket: scw.StringPtr(bucket),
+		Prefix: expandStringPtr(prefix),
+	}, func(page *s3.ListObjectVersionsOutput, _ bool) bool {
+		versions := page.Versions
+		if listed+int64(len(versions)) > sampleSize {
+			versions = versions[:sampleSize-listed]
+			sampled = true
+		}
+		usage.add(versions)
+		listed += int64(len(versions))
+
+		return !sampled
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed listing object versions in bucket %s: %w", bucket, err)
+	}
+
+	return sampled, nil
+}
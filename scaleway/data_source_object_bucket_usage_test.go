@@ -0,0 +1,123 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccScalewayDataSourceObjectBucketUsage_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-bucket-usage")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = "%s"
+					}
+
+					resource "scaleway_object" "files" {
+						for_each = toset(["a.txt", "dir/b.txt", "dir/c.txt", "other/d.txt"])
+						bucket = scaleway_object_bucket.main.name
+						key = each.key
+						content = "hello"
+					}
+
+					resource "scaleway_object" "onezone" {
+						bucket = scaleway_object_bucket.main.name
+						key = "dir/onezone.txt"
+						content = "hello world"
+						storage_class = "ONEZONE_IA"
+					}
+
+					data "scaleway_object_bucket_usage" "all" {
+						bucket = scaleway_object_bucket.main.name
+						depends_on = [scaleway_object.files, scaleway_object.onezone]
+					}
+
+					data "scaleway_object_bucket_usage" "dir" {
+						bucket = scaleway_object_bucket.main.name
+						prefix = "dir/"
+						depends_on = [scaleway_object.files, scaleway_object.onezone]
+					}
+
+					data "scaleway_object_bucket_usage" "sampled" {
+						bucket = scaleway_object_bucket.main.name
+						sample_size = 2
+						depends_on = [scaleway_object.files, scaleway_object.onezone]
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "total_size", "31"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "object_count", "5"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "version_count", "5"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "storage_class.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "storage_class.0.name", "ONEZONE_IA"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "storage_class.0.size", "11"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "storage_class.1.name", "STANDARD"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "storage_class.1.object_count", "4"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.all", "sampled", "false"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.dir", "object_count", "3"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.dir", "total_size", "21"),
+					resource.TestCheckResourceAttr("data.scaleway_object_bucket_usage.sampled", "sampled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestObjectBucketUsage(t *testing.T) {
+	usage := newObjectBucketUsage()
+	usage.add([]*s3.ObjectVersion{
+		{Key: scw.StringPtr("a"), Size: scw.Int64Ptr(10), IsLatest: scw.BoolPtr(true)},
+		{Key: scw.StringPtr("a"), Size: scw.Int64Ptr(5), IsLatest: scw.BoolPtr(false)},
+		{Key: scw.StringPtr("b"), Size: scw.Int64Ptr(20), IsLatest: scw.BoolPtr(true), StorageClass: scw.StringPtr(s3.StorageClassStandard)},
+	})
+	usage.add([]*s3.ObjectVersion{
+		{Key: scw.StringPtr("c"), Size: scw.Int64Ptr(100), IsLatest: scw.BoolPtr(true), StorageClass: scw.StringPtr("GLACIER")},
+	})
+
+	assert.Equal(t, objectStorageClassUsage{Size: 135, ObjectCount: 3, VersionCount: 4}, usage.total())
+	assert.Equal(t, []map[string]interface{}{
+		{"name": "GLACIER", "size": 100, "object_count": 1, "version_count": 1},
+		{"name": "STANDARD", "size": 35, "object_count": 2, "version_count": 3},
+	}, usage.flatten())
+}
+
+func TestListObjectBucketUsageSample(t *testing.T) {
+	fake := &fakeObjectVersionsS3{
+		pages: [][]string{{"a", "b"}, {"c", "d"}, {"e", "f"}},
+	}
+	s3Client := newFakeObjectVersionsS3Client(t, fake)
+
+	usage := newObjectBucketUsage()
+	sampled, err := listObjectBucketUsageSample(context.Background(), s3Client, "bucket", "", 3, usage)
+	require.NoError(t, err)
+	assert.True(t, sampled)
+	assert.Equal(t, int64(3), usage.total().VersionCount)
+	// The listing stops on the page reaching the sample size
+	assert.Equal(t, 2, fake.listCalls)
+
+	fake.listCalls = 0
+	usage = newObjectBucketUsage()
+	sampled, err = listObjectBucketUsageSample(context.Background(), s3Client, "bucket", "", 6, usage)
+	require.NoError(t, err)
+	assert.False(t, sampled)
+	assert.Equal(t, int64(6), usage.total().VersionCount)
+	assert.Equal(t, 3, fake.listCalls)
+}
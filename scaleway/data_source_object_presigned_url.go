@@ -0,0 +1,113 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const (
+	defaultObjectPresignedURLExpiration = time.Hour
+	maxObjectPresignedURLExpiration     = 7 * 24 * time.Hour
+)
+
+func dataSourceScalewayObjectPresignedURL() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayObjectPresignedURLRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Key of the object",
+			},
+			"method": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      http.MethodGet,
+				Description:  "HTTP method allowed by the URL",
+				ValidateFunc: validation.StringInSlice([]string{http.MethodGet, http.MethodPut, http.MethodHead, http.MethodDelete}, false),
+			},
+			"expires_in": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      int(defaultObjectPresignedURLExpiration.Seconds()),
+				Description:  "Number of seconds the URL is valid for",
+				ValidateFunc: validation.IntBetween(1, int(maxObjectPresignedURLExpiration.Seconds())),
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The presigned URL",
+			},
+			"expires_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Expiration date of the URL (RFC 3339 format)",
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayObjectPresignedURLRead(_ context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	key := d.Get("key").(string)
+	method := d.Get("method").(string)
+	expiresIn := time.Duration(d.Get("expires_in").(int)) * time.Second
+
+	var req *request.Request
+	switch method {
+	case http.MethodPut:
+		req, _ = s3Client.PutObjectRequest(&s3.PutObjectInput{
+			Bucket: scw.StringPtr(bucket),
+			Key:    scw.StringPtr(key),
+		})
+	case http.MethodHead:
+		req, _ = s3Client.HeadObjectRequest(&s3.HeadObjectInput{
+			Bucket: scw.StringPtr(bucket),
+			Key:    scw.StringPtr(key),
+		})
+	case http.MethodDelete:
+		req, _ = s3Client.DeleteObjectRequest(&s3.DeleteObjectInput{
+			Bucket: scw.StringPtr(bucket),
+			Key:    scw.StringPtr(key),
+		})
+	default:
+		req, _ = s3Client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: scw.StringPtr(bucket),
+			Key:    scw.StringPtr(key),
+		})
+	}
+
+	url, err := req.Presign(expiresIn)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed to presign %s request on object %s in bucket %s: %w", method, key, bucket, err))
+	}
+
+	d.SetId(newRegionalIDString(region, objectID(bucket, key)))
+	_ = d.Set("url", url)
+	_ = d.Set("expires_at", time.Now().Add(expiresIn).UTC().Format(time.RFC3339))
+	_ = d.Set("region", region)
+
+	return nil
+}
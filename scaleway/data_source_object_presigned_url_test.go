@@ -0,0 +1,44 @@
+package scaleway
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceObjectPresignedURL_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_object_presigned_url" "get" {
+						bucket     = "test-acc-scaleway-presigned-url"
+						key        = "artifacts/build.tar.gz"
+						expires_in = 600
+						region     = "nl-ams"
+					}
+
+					data "scaleway_object_presigned_url" "put" {
+						bucket = "test-acc-scaleway-presigned-url"
+						key    = "uploads/file"
+						method = "PUT"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.scaleway_object_presigned_url.get", "url", regexp.MustCompile(`^https://.*nl-ams.*artifacts/build\.tar\.gz\?.*X-Amz-Expires=600.*X-Amz-Signature=`)),
+					resource.TestCheckResourceAttrSet("data.scaleway_object_presigned_url.get", "expires_at"),
+					resource.TestMatchResourceAttr("data.scaleway_object_presigned_url.put", "url", regexp.MustCompile(`X-Amz-Expires=3600`)),
+					resource.TestCheckResourceAttr("data.scaleway_object_presigned_url.put", "method", "PUT"),
+				),
+			},
+		},
+	})
+}
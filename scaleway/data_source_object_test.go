@@ -0,0 +1,107 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccScalewayDataSourceObject_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-data")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = "%s"
+					}
+
+					resource "scaleway_object" "file" {
+						bucket = scaleway_object_bucket.main.name
+						key = "myfile"
+						content = "Hello World"
+						metadata = {
+							key = "value"
+						}
+						tags = {
+							foo = "bar"
+						}
+					}
+
+					data "scaleway_object" "selected" {
+						bucket = scaleway_object.file.bucket
+						key = scaleway_object.file.key
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "content_base64", "SGVsbG8gV29ybGQ="),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "content_length", "11"),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "etag", "b10a8db164e0754105b7a99be72e3fe5"),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "metadata.key", "value"),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "tags.foo", "bar"),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "visibility", "private"),
+					resource.TestCheckResourceAttrSet("data.scaleway_object.selected", "last_modified"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = "%s"
+					}
+
+					resource "scaleway_object" "file" {
+						bucket = scaleway_object_bucket.main.name
+						key = "myfile"
+						content = "Hello World"
+						metadata = {
+							key = "value"
+						}
+						tags = {
+							foo = "bar"
+						}
+					}
+
+					data "scaleway_object" "selected" {
+						bucket = scaleway_object.file.bucket
+						key = scaleway_object.file.key
+						max_body_size = 10
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "body", ""),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "content_base64", ""),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "content_length", "11"),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "etag", "b10a8db164e0754105b7a99be72e3fe5"),
+					resource.TestCheckResourceAttr("data.scaleway_object.selected", "metadata.key", "value"),
+				),
+			},
+		},
+	})
+}
+
+func TestObjectContentTypeIsText(t *testing.T) {
+	for contentType, expected := range map[string]bool{
+		"text/plain":               true,
+		"text/html; charset=utf-8": true,
+		"application/json":         true,
+		"application/vnd.api+json": true,
+		"image/svg+xml":            true,
+		"application/octet-stream": false,
+		"binary/octet-stream":      false,
+		"image/png":                false,
+		"":                         false,
+	} {
+		assert.Equal(t, expected, objectContentTypeIsText(contentType), contentType)
+	}
+}
@@ -0,0 +1,117 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+const defaultObjectsMaxKeys = 1000
+
+func dataSourceScalewayObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayObjectsRead,
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list keys starting with this prefix",
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Character used to group keys into common prefixes",
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list keys after this one",
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultObjectsMaxKeys,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Maximum number of keys to list",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the keys found",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the common prefixes found when a delimiter is set",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayObjectsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := d.Get("bucket").(string)
+	maxKeys := d.Get("max_keys").(int)
+
+	req := &s3.ListObjectsV2Input{
+		Bucket:     scw.StringPtr(bucket),
+		Prefix:     expandStringPtr(d.Get("prefix")),
+		Delimiter:  expandStringPtr(d.Get("delimiter")),
+		StartAfter: expandStringPtr(d.Get("start_after")),
+	}
+	if maxKeys < defaultObjectsMaxKeys {
+		req.MaxKeys = aws.Int64(int64(maxKeys))
+	}
+
+	keys := []string(nil)
+	commonPrefixes := []string(nil)
+	err = s3Client.ListObjectsV2PagesWithContext(ctx, req, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, prefix := range page.CommonPrefixes {
+			if len(keys)+len(commonPrefixes) >= maxKeys {
+				return false
+			}
+			commonPrefixes = append(commonPrefixes, aws.StringValue(prefix.Prefix))
+		}
+		for _, object := range page.Contents {
+			if len(keys)+len(commonPrefixes) >= maxKeys {
+				return false
+			}
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+
+		return len(keys)+len(commonPrefixes) < maxKeys
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing objects in bucket %s: %w", bucket, err))
+	}
+
+	d.SetId(newRegionalIDString(region, bucket))
+	_ = d.Set("keys", keys)
+	_ = d.Set("common_prefixes", commonPrefixes)
+	_ = d.Set("region", region)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package scaleway
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceObjects_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-objects-data")
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = "%s"
+					}
+
+					resource "scaleway_object" "files" {
+						for_each = toset(["a.txt", "dir/b.txt", "dir/c.txt", "other/d.txt"])
+						bucket = scaleway_object_bucket.main.name
+						key = each.key
+						content = each.key
+					}
+
+					data "scaleway_objects" "all" {
+						bucket = scaleway_object_bucket.main.name
+						depends_on = [scaleway_object.files]
+					}
+
+					data "scaleway_objects" "dir" {
+						bucket = scaleway_object_bucket.main.name
+						prefix = "dir/"
+						depends_on = [scaleway_object.files]
+					}
+
+					data "scaleway_objects" "delimiter" {
+						bucket = scaleway_object_bucket.main.name
+						delimiter = "/"
+						depends_on = [scaleway_object.files]
+					}
+
+					data "scaleway_objects" "limited" {
+						bucket = scaleway_object_bucket.main.name
+						max_keys = 2
+						depends_on = [scaleway_object.files]
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_objects.all", "keys.#", "4"),
+					resource.TestCheckResourceAttr("data.scaleway_objects.dir", "keys.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_objects.dir", "keys.0", "dir/b.txt"),
+					resource.TestCheckResourceAttr("data.scaleway_objects.delimiter", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.scaleway_objects.delimiter", "keys.0", "a.txt"),
+					resource.TestCheckResourceAttr("data.scaleway_objects.delimiter", "common_prefixes.#", "2"),
+					resource.TestCheckResourceAttr("data.scaleway_objects.limited", "keys.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,119 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceScalewayRDBEngineSettings() *schema.Resource {
+	settingSchema := &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the setting",
+			},
+			"default_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The value used when the setting is not set",
+			},
+			"hot_configurable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the setting can be applied without restarting the instance",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The description of the setting",
+			},
+			"property_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the setting (BOOLEAN, INT, STRING or FLOAT)",
+			},
+			"unit": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base unit of the setting",
+			},
+			"string_constraint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The validation regex for STRING settings",
+			},
+			"int_min": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The minimum value for INT settings",
+			},
+			"int_max": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum value for INT settings",
+			},
+			"float_min": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The minimum value for FLOAT settings",
+			},
+			"float_max": {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The maximum value for FLOAT settings",
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayRDBEngineSettingsRead,
+		Schema: map[string]*schema.Schema{
+			"engine": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The engine version (e.g. PostgreSQL-15)",
+			},
+			"settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The settings available on a running instance",
+				Elem:        settingSchema,
+			},
+			"init_settings": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The settings available at database initialisation",
+				Elem:        settingSchema,
+			},
+			"region": regionSchema(),
+		},
+	}
+}
+
+func dataSourceScalewayRDBEngineSettingsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	engine := d.Get("engine").(string)
+	version, err := rdbEngineVersion(ctx, rdbAPI, region, engine)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if version == nil {
+		return diag.FromErr(fmt.Errorf("engine version %s not found", engine))
+	}
+
+	d.SetId(newRegionalIDString(region, version.Name))
+	_ = d.Set("engine", version.Name)
+	_ = d.Set("settings", flattenRdbEngineSettings(version.AvailableSettings))
+	_ = d.Set("init_settings", flattenRdbEngineSettings(version.AvailableInitSettings))
+	_ = d.Set("region", region)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayDataSourceRdbEngineSettings_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					data "scaleway_rdb_engine_settings" "main" {
+						engine = "PostgreSQL-15"
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.scaleway_rdb_engine_settings.main", "engine", "PostgreSQL-15"),
+					resource.TestCheckResourceAttrSet("data.scaleway_rdb_engine_settings.main", "settings.0.name"),
+					resource.TestCheckResourceAttrSet("data.scaleway_rdb_engine_settings.main", "settings.0.property_type"),
+					resource.TestCheckResourceAttrSet("data.scaleway_rdb_engine_settings.main", "init_settings.0.name"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package scaleway
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func dataSourceScalewayRDBInstanceLogs() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceScalewayRDBInstanceLogsRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the database instance",
+				ValidateFunc: validationUUIDorUUIDWithLocality(),
+			},
+			"logs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The log files available on the database instance",
+				Elem:        &schema.Resource{Schema: rdbInstanceLogSchema()},
+			},
+			"region": regionSchema(),
+		},
+	}
+}
+
+func rdbInstanceLogSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the log file",
+		},
+		"node_name": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The name of the node the logs come from",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the log file",
+		},
+		"download_url": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Sensitive:   true,
+			Description: "The presigned URL to download the log file",
+		},
+		"created_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time of the log file's creation (RFC 3339 format)",
+		},
+		"expires_at": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Date and time of the log file's expiration (RFC 3339 format)",
+		},
+	}
+}
+
+func dataSourceScalewayRDBInstanceLogsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	region, instanceID, err := parseRegionalID(datasourceNewRegionalID(d.Get("instance_id"), region))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := rdbAPI.ListInstanceLogs(&rdb.ListInstanceLogsRequest{
+		Region:     region,
+		InstanceID: instanceID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, instanceID))
+	_ = d.Set("instance_id", newRegionalIDString(region, instanceID))
+	_ = d.Set("logs", flattenRdbInstanceLogs(res.InstanceLogs))
+	_ = d.Set("region", region)
+
+	return nil
+}
@@ -636,6 +636,13 @@ func flattenInt32Ptr(i *int32) interface{} {
 	return *i
 }
 
+func flattenFloat32Ptr(f *float32) interface{} {
+	if f == nil {
+		return 0
+	}
+	return *f
+}
+
 func flattenUint32Ptr(i *uint32) interface{} {
 	if i == nil {
 		return 0
@@ -3,9 +3,13 @@ package scaleway
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
 	"fmt"
 	"net"
 	"reflect"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -14,6 +18,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/api/instance/v1"
+	ipam "github.com/scaleway/scaleway-sdk-go/api/ipam/v1"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	validator "github.com/scaleway/scaleway-sdk-go/validation"
@@ -22,6 +28,8 @@ import (
 const (
 	defaultLbLbTimeout = 10 * time.Minute
 	retryLbIPInterval  = 5 * time.Second
+	// Let's Encrypt certificates are valid for 90 days, a longer renewal window would renew them on every apply
+	maxLbCertificateRenewBeforeDays = 89
 )
 
 // lbAPIWithZone returns an lb API WITH zone for a Create request
@@ -389,6 +397,139 @@ func expandLbCustomCertificate(raw interface{}) *lbSDK.CreateCertificateRequestC
 	return config
 }
 
+func flattenLbLetsEncrypt(certificate *lbSDK.Certificate) interface{} {
+	return []map[string]interface{}{
+		{
+			"common_name":              certificate.CommonName,
+			"subject_alternative_name": certificate.SubjectAlternativeName,
+		},
+	}
+}
+
+// lbCertificateChainNotValidAfter returns the upper validity bound of the first certificate of a PEM-formatted chain
+func lbCertificateChainNotValidAfter(chain string) (*time.Time, error) {
+	rest := []byte(chain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("no certificate found in certificate chain")
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		certificate, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return &certificate.NotAfter, nil
+	}
+}
+
+// lbCertificateNeedsRenewal returns true if the certificate expires in less than renewBeforeDays from now
+func lbCertificateNeedsRenewal(notValidAfter *time.Time, renewBeforeDays int, now time.Time) bool {
+	if notValidAfter == nil || renewBeforeDays <= 0 {
+		return false
+	}
+	return notValidAfter.Sub(now) < time.Duration(renewBeforeDays)*24*time.Hour
+}
+
+// lbCertificateRenewalDue returns true if a Let's Encrypt certificate is in error status or expires in less than renewBeforeDays from now
+func lbCertificateRenewalDue(letsencrypt bool, status string, notValidAfter *time.Time, renewBeforeDays int, now time.Time) bool {
+	if !letsencrypt || renewBeforeDays <= 0 {
+		return false
+	}
+	return status == lbSDK.CertificateStatusError.String() || lbCertificateNeedsRenewal(notValidAfter, renewBeforeDays, now)
+}
+
+// lbCertificateReissue replaces a Let's Encrypt certificate by a new one with the same configuration.
+// The frontends of the load-balancer using the previous certificate are moved to the new one before it is deleted.
+func lbCertificateReissue(ctx context.Context, lbAPI *lbSDK.ZonedAPI, zone scw.Zone, lbID string, certificateID string, d *schema.ResourceData) (*lbSDK.Certificate, error) {
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	_, err := waitForLB(ctx, lbAPI, zone, lbID, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := lbAPI.CreateCertificate(&lbSDK.ZonedAPICreateCertificateRequest{
+		Zone:        zone,
+		LBID:        lbID,
+		Name:        d.Get("name").(string),
+		Letsencrypt: expandLbLetsEncrypt(d.Get("letsencrypt")),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err = waitForLBCertificate(ctx, lbAPI, zone, certificate.ID, timeout)
+	if err == nil && certificate.Status == lbSDK.CertificateStatusError {
+		err = fmt.Errorf("certificate %s is in error status: %s", certificate.ID, flattenStringPtr(certificate.StatusDetails))
+	}
+	if err != nil {
+		// The previous certificate is still in use, the new one is removed
+		if certificate != nil {
+			_ = lbAPI.DeleteCertificate(&lbSDK.ZonedAPIDeleteCertificateRequest{
+				Zone:          zone,
+				CertificateID: certificate.ID,
+			}, scw.WithContext(ctx))
+		}
+		return nil, err
+	}
+
+	frontends, err := lbAPI.ListFrontends(&lbSDK.ZonedAPIListFrontendsRequest{
+		Zone: zone,
+		LBID: lbID,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, frontend := range frontends.Frontends {
+		certificateIDs := make([]string, len(frontend.CertificateIDs))
+		replaced := false
+		for i, frontendCertificateID := range frontend.CertificateIDs {
+			certificateIDs[i] = frontendCertificateID
+			if frontendCertificateID == certificateID {
+				certificateIDs[i] = certificate.ID
+				replaced = true
+			}
+		}
+		if !replaced {
+			continue
+		}
+
+		_, err = lbAPI.UpdateFrontend(&lbSDK.ZonedAPIUpdateFrontendRequest{
+			Zone:           zone,
+			FrontendID:     frontend.ID,
+			Name:           frontend.Name,
+			InboundPort:    frontend.InboundPort,
+			BackendID:      frontend.Backend.ID,
+			TimeoutClient:  frontend.TimeoutClient,
+			CertificateIDs: &certificateIDs,
+			EnableHTTP3:    frontend.EnableHTTP3,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return nil, fmt.Errorf("failed to move frontend %s to certificate %s: %w", frontend.ID, certificate.ID, err)
+		}
+
+		_, err = waitForLB(ctx, lbAPI, zone, lbID, timeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = lbAPI.DeleteCertificate(&lbSDK.ZonedAPIDeleteCertificateRequest{
+		Zone:          zone,
+		CertificateID: certificateID,
+	}, scw.WithContext(ctx))
+	if err != nil && !is404Error(err) {
+		return nil, err
+	}
+
+	return certificate, nil
+}
+
 func expandLbProxyProtocol(raw interface{}) lbSDK.ProxyProtocol {
 	return lbSDK.ProxyProtocol("proxy_protocol_" + raw.(string))
 }
@@ -629,3 +770,99 @@ func lbPrivateNetworkSetHash(v interface{}) int {
 
 	return StringHashcode(buf.String())
 }
+
+// lbBackendSelectServerIPs resolves the private IPv4 addresses of the instances matched by a backend server_selector.
+// Instances are looked up in the given zone, their private NICs IPs are read from IPAM.
+func lbBackendSelectServerIPs(ctx context.Context, m interface{}, zone scw.Zone, rawSelector interface{}) ([]string, error) {
+	meta := m.(*Meta)
+	selector := rawSelector.([]interface{})[0].(map[string]interface{})
+	privateNetworkID := expandID(selector["private_network_id"])
+
+	region, err := zone.Region()
+	if err != nil {
+		return nil, err
+	}
+
+	servers, err := instance.NewAPI(meta.scwClient).ListServers(&instance.ListServersRequest{
+		Zone:           zone,
+		PrivateNetwork: &privateNetworkID,
+		Tags:           expandStrings(selector["instance_tags"]),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	privateNICIDs := make(map[string]bool)
+	for _, server := range servers.Servers {
+		for _, nic := range server.PrivateNics {
+			if nic.PrivateNetworkID == privateNetworkID {
+				privateNICIDs[nic.ID] = true
+			}
+		}
+	}
+
+	if len(privateNICIDs) == 0 {
+		return nil, nil
+	}
+
+	ips, err := ipam.NewAPI(meta.scwClient).ListIPs(&ipam.ListIPsRequest{
+		Region:           region,
+		PrivateNetworkID: &privateNetworkID,
+		ResourceType:     ipam.ResourceTypeInstancePrivateNic,
+		IsIPv6:           scw.BoolPtr(false),
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	serverIPs := []string(nil)
+	for _, ip := range ips.IPs {
+		if ip.Resource != nil && privateNICIDs[ip.Resource.ID] {
+			serverIPs = append(serverIPs, ip.Address.IP.String())
+		}
+	}
+	sort.Strings(serverIPs)
+
+	return serverIPs, nil
+}
+
+// resourceScalewayLbBackendCustomizeDiff keeps server_ips in sync with the instances matched by server_selector.
+func resourceScalewayLbBackendCustomizeDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if _, ok := diff.GetOk("server_selector"); !ok {
+		// server_ips is computed when using a selector, an unset list must still empty the backend servers.
+		rawConfig := diff.GetRawConfig()
+		if !rawConfig.IsNull() && rawConfig.GetAttr("server_ips").IsNull() && len(diff.Get("server_ips").([]interface{})) > 0 {
+			return diff.SetNew("server_ips", []interface{}{})
+		}
+		return nil
+	}
+
+	// The selection is resolved at apply time when the selector is unknown or changing,
+	// this is the case when triggers reference instances that are created or replaced in the same plan.
+	rawConfig := diff.GetRawConfig()
+	selectorKnown := rawConfig.IsNull() || rawConfig.GetAttr("server_selector").IsWhollyKnown()
+	if !diff.NewValueKnown("lb_id") || !selectorKnown || diff.HasChange("server_selector") {
+		return diff.SetNewComputed("server_ips")
+	}
+
+	zone, _, err := parseZonedID(diff.Get("lb_id").(string))
+	if err != nil {
+		zone, err = extractZone(diff, meta.(*Meta))
+		if err != nil {
+			return err
+		}
+	}
+
+	serverIPs, err := lbBackendSelectServerIPs(ctx, meta, zone, diff.Get("server_selector"))
+	if err != nil {
+		return err
+	}
+
+	currentIPs := expandStrings(diff.Get("server_ips"))
+	sort.Strings(currentIPs)
+	if reflect.DeepEqual(currentIPs, serverIPs) {
+		return nil
+	}
+
+	return diff.SetNew("server_ips", serverIPs)
+}
@@ -1,11 +1,20 @@
 package scaleway
 
 import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestIsEqualPrivateNetwork(t *testing.T) {
@@ -52,3 +61,112 @@ func TestIsEqualPrivateNetwork(t *testing.T) {
 		})
 	}
 }
+
+func TestLbCertificateChainNotValidAfter(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	notValidAfter := time.Now().Add(-24 * time.Hour).UTC().Truncate(time.Second)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "example.org"},
+		NotBefore:    notValidAfter.Add(-90 * 24 * time.Hour),
+		NotAfter:     notValidAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	chain := string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})) +
+		string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}))
+
+	actual, err := lbCertificateChainNotValidAfter(chain)
+	require.NoError(t, err)
+	assert.True(t, notValidAfter.Equal(*actual))
+
+	_, err = lbCertificateChainNotValidAfter("not a certificate")
+	assert.Error(t, err)
+}
+
+func TestLbCertificateNeedsRenewal(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	// Let's Encrypt certificates are valid for 90 days
+	issued := now.AddDate(0, 0, 90)
+
+	tests := []struct {
+		name            string
+		notValidAfter   *time.Time
+		renewBeforeDays int
+		now             time.Time
+		expected        bool
+	}{
+		{
+			name:            "noExpiration",
+			notValidAfter:   nil,
+			renewBeforeDays: 30,
+			now:             now,
+			expected:        false,
+		},
+		{
+			name:            "renewalDisabled",
+			notValidAfter:   scw.TimePtr(now.Add(24 * time.Hour)),
+			renewBeforeDays: 0,
+			now:             now,
+			expected:        false,
+		},
+		{
+			name:            "justIssued",
+			notValidAfter:   &issued,
+			renewBeforeDays: 30,
+			now:             now,
+			expected:        false,
+		},
+		{
+			name:            "justIssuedMaxWindow",
+			notValidAfter:   &issued,
+			renewBeforeDays: maxLbCertificateRenewBeforeDays,
+			now:             now,
+			expected:        false,
+		},
+		{
+			name:            "windowStart",
+			notValidAfter:   &issued,
+			renewBeforeDays: 30,
+			now:             issued.AddDate(0, 0, -30),
+			expected:        false,
+		},
+		{
+			name:            "insideWindow",
+			notValidAfter:   &issued,
+			renewBeforeDays: 30,
+			now:             issued.AddDate(0, 0, -30).Add(time.Second),
+			expected:        true,
+		},
+		{
+			name:            "expired",
+			notValidAfter:   &issued,
+			renewBeforeDays: 30,
+			now:             issued.Add(24 * time.Hour),
+			expected:        true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, lbCertificateNeedsRenewal(tt.notValidAfter, tt.renewBeforeDays, tt.now))
+		})
+	}
+}
+
+func TestLbCertificateRenewalDue(t *testing.T) {
+	now := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	inWindow := scw.TimePtr(now.AddDate(0, 0, 10))
+	outsideWindow := scw.TimePtr(now.AddDate(0, 0, 60))
+
+	assert.True(t, lbCertificateRenewalDue(true, lbSDK.CertificateStatusReady.String(), inWindow, 30, now))
+	assert.True(t, lbCertificateRenewalDue(true, lbSDK.CertificateStatusError.String(), outsideWindow, 30, now))
+	assert.False(t, lbCertificateRenewalDue(true, lbSDK.CertificateStatusReady.String(), outsideWindow, 30, now))
+	assert.False(t, lbCertificateRenewalDue(true, lbSDK.CertificateStatusError.String(), inWindow, 0, now))
+	// Custom certificates are never re-issued
+	assert.False(t, lbCertificateRenewalDue(false, lbSDK.CertificateStatusError.String(), inWindow, 30, now))
+}
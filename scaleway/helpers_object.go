@@ -3,13 +3,22 @@ package scaleway
 import (
 	"bytes"
 	"context"
+	"crypto/md5" //nolint:gosec // MD5 is only used to compare files with object ETags
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"hash/crc32"
+	"io"
+	"io/fs"
+	"mime"
 	"net/http"
 	"os"
+	"path"
+	"path/filepath"
 	"runtime"
 	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -21,6 +30,7 @@ import (
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/logging"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/scaleway/terraform-provider-scaleway/v2/internal"
@@ -30,6 +40,14 @@ const (
 	defaultObjectBucketTimeout = 10 * time.Minute
 
 	maxObjectVersionDeletionWorkers = 8
+	maxObjectDeletionBatchSize      = 1000
+
+	defaultObjectMultipartThreshold = 100 * 1024 * 1024
+	minObjectMultipartPartSize      = 5 * 1024 * 1024
+	maxObjectMultipartParts         = 10000
+	maxObjectMultipartWorkers       = 8
+	maxObjectMultipartPartAttempts  = 3
+	objectMultipartPartRetryDelay   = 2 * time.Second
 )
 
 func newS3Client(httpClient *http.Client, region, accessKey, secretKey string) (*s3.S3, error) {
@@ -289,22 +307,6 @@ func expandBucketCORS(ctx context.Context, rawCors []interface{}, bucket string)
 	return rules
 }
 
-func deleteS3ObjectVersion(conn *s3.S3, bucketName string, key string, versionID string, force bool) error {
-	input := &s3.DeleteObjectInput{
-		Bucket: scw.StringPtr(bucketName),
-		Key:    scw.StringPtr(key),
-	}
-	if versionID != "" {
-		input.VersionId = scw.StringPtr(versionID)
-	}
-	if force {
-		input.BypassGovernanceRetention = scw.BoolPtr(force)
-	}
-
-	_, err := conn.DeleteObject(input)
-	return err
-}
-
 // removeS3ObjectVersionLegalHold remove legal hold from an ObjectVersion if it is on
 // returns true if legal hold was removed
 func removeS3ObjectVersionLegalHold(conn *s3.S3, bucketName string, objectVersion *s3.ObjectVersion) (bool, error) {
@@ -335,96 +337,141 @@ func removeS3ObjectVersionLegalHold(conn *s3.S3, bucketName string, objectVersio
 	return true, nil
 }
 
-func deleteS3ObjectVersions(ctx context.Context, conn *s3.S3, bucketName string, force bool) error {
-	var globalErr error
-	listInput := &s3.ListObjectVersionsInput{
-		Bucket: scw.StringPtr(bucketName),
+// deleteS3ObjectVersions deletes every object version and delete marker of a bucket.
+// Pages are deleted with batched DeleteObjects calls by a pool of workers while the listing goes on.
+// When workers is not positive, it defaults to the number of CPUs, up to maxObjectVersionDeletionWorkers.
+func deleteS3ObjectVersions(ctx context.Context, conn *s3.S3, bucketName string, force bool, workers int) error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+		if workers > maxObjectVersionDeletionWorkers {
+			workers = maxObjectVersionDeletionWorkers
+		}
 	}
 
-	deletionWorkers := runtime.NumCPU()
-	if deletionWorkers > maxObjectVersionDeletionWorkers {
-		deletionWorkers = maxObjectVersionDeletionWorkers
-	}
+	// Listing is stopped as soon as a batch fails
+	listCtx, cancelList := context.WithCancel(ctx)
+	defer cancelList()
 
-	listErr := conn.ListObjectVersionsPagesWithContext(ctx, listInput, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
-		pool := internal.NewWorkerPool(deletionWorkers)
+	pool := internal.NewWorkerPool(workers)
+	// Limit the number of listed batches waiting for a worker
+	pendingBatches := make(chan struct{}, workers*2)
+	deleted := int64(0)
 
+	listErr := conn.ListObjectVersionsPagesWithContext(listCtx, &s3.ListObjectVersionsInput{
+		Bucket: scw.StringPtr(bucketName),
+	}, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+		objects := make([]*s3.ObjectIdentifier, 0, len(page.Versions)+len(page.DeleteMarkers))
 		for _, objectVersion := range page.Versions {
-			objectVersion := objectVersion
+			objects = append(objects, &s3.ObjectIdentifier{Key: objectVersion.Key, VersionId: objectVersion.VersionId})
+		}
+		for _, deleteMarker := range page.DeleteMarkers {
+			objects = append(objects, &s3.ObjectIdentifier{Key: deleteMarker.Key, VersionId: deleteMarker.VersionId})
+		}
 
-			pool.AddTask(func() error {
-				objectKey := aws.StringValue(objectVersion.Key)
-				objectVersionID := aws.StringValue(objectVersion.VersionId)
-				err := deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
-
-				if isS3Err(err, ErrCodeAccessDenied, "") && force {
-					legalHoldRemoved, errLegal := removeS3ObjectVersionLegalHold(conn, bucketName, objectVersion)
-					if errLegal != nil {
-						return fmt.Errorf("failed to remove legal hold: %s", errLegal)
-					}
+		for _, batch := range objectIdentifiersBatches(objects, maxObjectDeletionBatchSize) {
+			batch := batch
 
-					if legalHoldRemoved {
-						err = deleteS3ObjectVersion(conn, bucketName, objectKey, objectVersionID, force)
-					}
-				}
+			select {
+			case pendingBatches <- struct{}{}:
+			case <-listCtx.Done():
+				return false
+			}
 
+			pool.AddTask(func() error {
+				defer func() { <-pendingBatches }()
+
+				count, err := deleteS3ObjectVersionsBatch(ctx, conn, bucketName, batch, force)
+				total := atomic.AddInt64(&deleted, int64(count))
+				tflog.Info(ctx, fmt.Sprintf("deleted %d object versions from bucket %s", total, bucketName))
 				if err != nil {
-					return fmt.Errorf("failed to delete S3 object: %s", err)
+					cancelList()
+					return err
 				}
 
 				return nil
 			})
 		}
 
-		errors := pool.CloseAndWait()
-		if len(errors) > 0 {
-			globalErr = multierror.Append(nil, errors...)
-			return false
-		}
-
 		return true
 	})
-	if listErr != nil {
-		return fmt.Errorf("error listing S3 objects: %s", globalErr)
+
+	errs := pool.CloseAndWait()
+	if len(errs) > 0 {
+		return multierror.Append(nil, errs...)
 	}
-	if globalErr != nil {
-		return globalErr
+	if listErr != nil {
+		return fmt.Errorf("error listing S3 objects: %s", listErr)
 	}
 
-	listErr = conn.ListObjectVersionsPagesWithContext(ctx, listInput, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
-		pool := internal.NewWorkerPool(deletionWorkers)
+	return nil
+}
 
-		for _, deleteMarkerEntry := range page.DeleteMarkers {
-			deleteMarkerEntry := deleteMarkerEntry
+// deleteS3ObjectVersionsBatch deletes up to maxObjectDeletionBatchSize object versions in a single request and returns how many were deleted.
+// With force, governance retention is bypassed and the versions rejected because of a legal hold are deleted again once the hold is removed.
+func deleteS3ObjectVersionsBatch(ctx context.Context, conn *s3.S3, bucketName string, objects []*s3.ObjectIdentifier, force bool) (int, error) {
+	input := &s3.DeleteObjectsInput{
+		Bucket: scw.StringPtr(bucketName),
+		Delete: &s3.Delete{
+			Objects: objects,
+			Quiet:   scw.BoolPtr(true),
+		},
+	}
+	if force {
+		input.BypassGovernanceRetention = scw.BoolPtr(force)
+	}
 
-			pool.AddTask(func() error {
-				deleteMarkerKey := aws.StringValue(deleteMarkerEntry.Key)
-				deleteMarkerVersionsID := aws.StringValue(deleteMarkerEntry.VersionId)
-				err := deleteS3ObjectVersion(conn, bucketName, deleteMarkerKey, deleteMarkerVersionsID, force)
-				if err != nil {
-					return fmt.Errorf("failed to delete S3 object delete marker: %s", err)
-				}
+	res, err := conn.DeleteObjectsWithContext(ctx, input)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete S3 objects: %s", err)
+	}
 
-				return nil
+	deleted := len(objects) - len(res.Errors)
+	var errs *multierror.Error
+	var legalHoldRemoved []*s3.ObjectIdentifier
+
+	for _, deleteErr := range res.Errors {
+		if force && aws.StringValue(deleteErr.Code) == ErrCodeAccessDenied {
+			removed, errLegal := removeS3ObjectVersionLegalHold(conn, bucketName, &s3.ObjectVersion{
+				Key:       deleteErr.Key,
+				VersionId: deleteErr.VersionId,
 			})
+			if errLegal != nil {
+				errs = multierror.Append(errs, fmt.Errorf("failed to remove legal hold: %s", errLegal))
+				continue
+			}
+			if removed {
+				legalHoldRemoved = append(legalHoldRemoved, &s3.ObjectIdentifier{Key: deleteErr.Key, VersionId: deleteErr.VersionId})
+				continue
+			}
 		}
 
-		errors := pool.CloseAndWait()
-		if len(errors) > 0 {
-			globalErr = multierror.Append(nil, errors...)
-			return false
+		errs = multierror.Append(errs, fmt.Errorf("failed to delete S3 object %s (version %s): %s: %s",
+			aws.StringValue(deleteErr.Key), aws.StringValue(deleteErr.VersionId), aws.StringValue(deleteErr.Code), aws.StringValue(deleteErr.Message)))
+	}
+
+	if len(legalHoldRemoved) > 0 {
+		count, err := deleteS3ObjectVersionsBatch(ctx, conn, bucketName, legalHoldRemoved, force)
+		deleted += count
+		if err != nil {
+			errs = multierror.Append(errs, err)
 		}
+	}
 
-		return true
-	})
-	if listErr != nil {
-		return fmt.Errorf("error listing S3 objects for delete markers: %s", globalErr)
+	return deleted, errs.ErrorOrNil()
+}
+
+// objectIdentifiersBatches splits objects in batches of at most size objects
+func objectIdentifiersBatches(objects []*s3.ObjectIdentifier, size int) [][]*s3.ObjectIdentifier {
+	var batches [][]*s3.ObjectIdentifier
+	for len(objects) > size {
+		batches = append(batches, objects[:size])
+		objects = objects[size:]
 	}
-	if globalErr != nil {
-		return globalErr
+	if len(objects) > 0 {
+		batches = append(batches, objects)
 	}
 
-	return nil
+	return batches
 }
 
 func transitionHash(v interface{}) int {
@@ -564,3 +611,603 @@ func normalizeOwnerID(id *string) *string {
 
 	return &tab[0]
 }
+
+// objectBucketSyncFile is a local file managed by a scaleway_object_bucket_sync
+type objectBucketSyncFile struct {
+	Path string
+	Hash string
+}
+
+// objectBucketSyncMatch returns whether the relative slash-separated path matches one of the glob patterns.
+// Patterns without a slash are also matched against the file name.
+func objectBucketSyncMatch(patterns []string, rel string) bool {
+	for _, pattern := range patterns {
+		if matched, _ := path.Match(pattern, rel); matched {
+			return true
+		}
+		if !strings.Contains(pattern, "/") {
+			if matched, _ := path.Match(pattern, path.Base(rel)); matched {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// objectBucketSyncIsManaged returns whether a path relative to the sync prefix passes the include and exclude filters
+func objectBucketSyncIsManaged(rel string, include, exclude []string) bool {
+	if len(include) > 0 && !objectBucketSyncMatch(include, rel) {
+		return false
+	}
+
+	return !objectBucketSyncMatch(exclude, rel)
+}
+
+// objectBucketSyncLocalFiles walks the source directory and returns the files to sync indexed by object key
+func objectBucketSyncLocalFiles(sourceDir string, prefix string, include, exclude []string) (map[string]objectBucketSyncFile, error) {
+	files := make(map[string]objectBucketSyncFile)
+
+	err := filepath.WalkDir(sourceDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if !objectBucketSyncIsManaged(rel, include, exclude) {
+			return nil
+		}
+
+		hash, err := objectBucketSyncFileHash(filePath)
+		if err != nil {
+			return err
+		}
+
+		files[prefix+rel] = objectBucketSyncFile{
+			Path: filePath,
+			Hash: hash,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read source directory %s: %w", sourceDir, err)
+	}
+
+	return files, nil
+}
+
+// objectBucketSyncFileHash returns the MD5 of a file, which is the ETag of an object uploaded in a single part
+func objectBucketSyncFileHash(filePath string) (string, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := md5.New() //nolint:gosec // Object ETags are MD5 hashes
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// objectBucketSyncContentType returns the content type of a key, from the user mapping by extension or guessed from the extension
+func objectBucketSyncContentType(key string, contentTypes map[string]interface{}) *string {
+	ext := path.Ext(key)
+	if contentType, exists := contentTypes[ext]; exists {
+		return expandStringPtr(contentType)
+	}
+
+	return expandStringPtr(mime.TypeByExtension(ext))
+}
+
+// objectBucketSyncCacheControl returns the value of the first cache control rule matching a path relative to the sync prefix
+func objectBucketSyncCacheControl(rel string, rules []interface{}) *string {
+	for _, rawRule := range rules {
+		rule := rawRule.(map[string]interface{})
+		if objectBucketSyncMatch([]string{rule["pattern"].(string)}, rel) {
+			return expandStringPtr(rule["value"])
+		}
+	}
+
+	return nil
+}
+
+// objectMultipartPartSize returns the size of the parts used to upload a file of the given size,
+// keeping the number of parts under the S3 limit
+func objectMultipartPartSize(size int64) int64 {
+	partSize := int64(minObjectMultipartPartSize)
+	for size/partSize >= maxObjectMultipartParts {
+		partSize *= 2
+	}
+
+	return partSize
+}
+
+// objectMultipartETag returns the ETag S3 computes for a multipart upload: the MD5 of the parts MD5 followed by the number of parts
+func objectMultipartETag(partHashes [][]byte) string {
+	hash := md5.New() //nolint:gosec // Object ETags are MD5 hashes
+	for _, partHash := range partHashes {
+		hash.Write(partHash)
+	}
+
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(hash.Sum(nil)), len(partHashes))
+}
+
+// objectFileMatchesETag returns whether the content of a file matches the ETag of an object.
+// Multipart ETags are compared with the ETag of the file split in parts of the size used to upload it.
+func objectFileMatchesETag(filePath string, etag string) (bool, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	if !strings.Contains(etag, "-") {
+		hash := md5.New() //nolint:gosec // Object ETags are MD5 hashes
+		if _, err := io.Copy(hash, file); err != nil {
+			return false, err
+		}
+
+		return hex.EncodeToString(hash.Sum(nil)) == etag, nil
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		return false, err
+	}
+
+	partSize := objectMultipartPartSize(info.Size())
+	var partHashes [][]byte
+	for offset := int64(0); offset < info.Size(); offset += partSize {
+		hash := md5.New() //nolint:gosec // Object ETags are MD5 hashes
+		if _, err := io.Copy(hash, io.NewSectionReader(file, offset, partSize)); err != nil {
+			return false, err
+		}
+		partHashes = append(partHashes, hash.Sum(nil))
+	}
+
+	return objectMultipartETag(partHashes) == etag, nil
+}
+
+// objectContent returns the content of a scaleway_object uploaded from content or content_base64, empty when none is set
+func objectContent(d *schema.ResourceData) ([]byte, error) {
+	if contentBase64, hasContent := d.GetOk("content_base64"); hasContent {
+		return base64.StdEncoding.DecodeString(contentBase64.(string))
+	}
+
+	return []byte(d.Get("content").(string)), nil
+}
+
+// objectContentMatchesETag returns whether the file or content of a scaleway_object matches the ETag and size of the stored object.
+// The file is only hashed when its size matches. A file missing from the disk is considered as matching,
+// the state may be refreshed where the file is not available.
+func objectContentMatchesETag(d *schema.ResourceData, etag string, size int64) (bool, error) {
+	if filePath, hasFile := d.GetOk("file"); hasFile {
+		info, err := os.Stat(filePath.(string))
+		if errors.Is(err, fs.ErrNotExist) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if info.Size() != size {
+			return false, nil
+		}
+
+		return objectFileMatchesETag(filePath.(string), etag)
+	}
+
+	content, err := objectContent(d)
+	if err != nil {
+		return false, err
+	}
+	if int64(len(content)) != size {
+		return false, nil
+	}
+	hash := md5.Sum(content) //nolint:gosec // Object ETags are MD5 hashes
+
+	return hex.EncodeToString(hash[:]) == etag, nil
+}
+
+// uploadS3ObjectFile uploads a file with the parameters of req, using a multipart upload when the file is larger than threshold
+func uploadS3ObjectFile(ctx context.Context, conn *s3.S3, req *s3.PutObjectInput, filePath string, threshold int64) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if info.Size() < threshold {
+		req.Body = file
+		_, err = conn.PutObjectWithContext(ctx, req)
+		return err
+	}
+
+	return uploadS3ObjectMultipart(ctx, conn, req, file, info.Size())
+}
+
+// uploadS3ObjectMultipart uploads the parts of a file in parallel and aborts the upload on failure or cancellation
+func uploadS3ObjectMultipart(ctx context.Context, conn *s3.S3, req *s3.PutObjectInput, file *os.File, size int64) error {
+	upload, err := conn.CreateMultipartUploadWithContext(ctx, &s3.CreateMultipartUploadInput{
+		ACL:                  req.ACL,
+		Bucket:               req.Bucket,
+		Key:                  req.Key,
+		StorageClass:         req.StorageClass,
+		Metadata:             req.Metadata,
+		SSECustomerAlgorithm: req.SSECustomerAlgorithm,
+		SSECustomerKey:       req.SSECustomerKey,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create multipart upload: %w", err)
+	}
+
+	partSize := objectMultipartPartSize(size)
+	partsCount := int((size + partSize - 1) / partSize)
+	parts := make([]*s3.CompletedPart, partsCount)
+	partHashes := make([][]byte, partsCount)
+
+	tflog.Debug(ctx, fmt.Sprintf("uploading object %s in %d parts of %d bytes", aws.StringValue(req.Key), partsCount, partSize))
+
+	workers := partsCount
+	if workers > maxObjectMultipartWorkers {
+		workers = maxObjectMultipartWorkers
+	}
+	pool := internal.NewWorkerPool(workers)
+
+	// Remaining parts are skipped as soon as one of them fails
+	partsCtx, cancelParts := context.WithCancel(ctx)
+	defer cancelParts()
+
+	for i := 0; i < partsCount; i++ {
+		partIndex := i
+		pool.AddTask(func() error {
+			if partsCtx.Err() != nil {
+				return nil
+			}
+
+			offset := int64(partIndex) * partSize
+			section := io.NewSectionReader(file, offset, partSize)
+
+			hash := md5.New() //nolint:gosec // Object ETags are MD5 hashes
+			if _, err := io.Copy(hash, section); err != nil {
+				cancelParts()
+				return err
+			}
+			partHash := hash.Sum(nil)
+
+			attempts := 0
+			res, err := retryWhen(partsCtx, &RetryWhenConfig[*s3.UploadPartOutput]{
+				Timeout:  defaultObjectBucketTimeout,
+				Interval: objectMultipartPartRetryDelay,
+				Function: func() (*s3.UploadPartOutput, error) {
+					attempts++
+					return conn.UploadPartWithContext(partsCtx, &s3.UploadPartInput{
+						Bucket:               req.Bucket,
+						Key:                  req.Key,
+						UploadId:             upload.UploadId,
+						PartNumber:           aws.Int64(int64(partIndex + 1)),
+						Body:                 io.NewSectionReader(file, offset, partSize),
+						ContentMD5:           scw.StringPtr(base64.StdEncoding.EncodeToString(partHash)),
+						SSECustomerAlgorithm: req.SSECustomerAlgorithm,
+						SSECustomerKey:       req.SSECustomerKey,
+					})
+				},
+			}, func(err error) bool {
+				return err != nil && partsCtx.Err() == nil && attempts < maxObjectMultipartPartAttempts
+			})
+			if err != nil {
+				cancelParts()
+				return fmt.Errorf("failed to upload part %d: %w", partIndex+1, err)
+			}
+
+			parts[partIndex] = &s3.CompletedPart{
+				ETag:       res.ETag,
+				PartNumber: aws.Int64(int64(partIndex + 1)),
+			}
+			partHashes[partIndex] = partHash
+
+			return nil
+		})
+	}
+
+	errs := pool.CloseAndWait()
+	if err := ctx.Err(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) == 0 {
+		var res *s3.CompleteMultipartUploadOutput
+		res, err = conn.CompleteMultipartUploadWithContext(ctx, &s3.CompleteMultipartUploadInput{
+			Bucket:          req.Bucket,
+			Key:             req.Key,
+			UploadId:        upload.UploadId,
+			MultipartUpload: &s3.CompletedMultipartUpload{Parts: parts},
+		})
+		if err == nil {
+			// The ETag of objects encrypted with a customer key is not computed from their content
+			etag := strings.Trim(aws.StringValue(res.ETag), `"`)
+			if expected := objectMultipartETag(partHashes); req.SSECustomerKey == nil && etag != expected {
+				return fmt.Errorf("uploaded object %s has ETag %s instead of %s", aws.StringValue(req.Key), etag, expected)
+			}
+			return nil
+		}
+		errs = append(errs, fmt.Errorf("failed to complete multipart upload: %w", err))
+	}
+
+	// The context may be cancelled, the upload is aborted regardless to not leave orphan parts billed in the bucket
+	_, abortErr := conn.AbortMultipartUploadWithContext(context.Background(), &s3.AbortMultipartUploadInput{
+		Bucket:   req.Bucket,
+		Key:      req.Key,
+		UploadId: upload.UploadId,
+	})
+	if abortErr != nil {
+		errs = append(errs, fmt.Errorf("failed to abort multipart upload: %w", abortErr))
+	}
+
+	return multierror.Append(nil, errs...)
+}
+
+// expandObjectSSECustomerKey decodes a base64 encoded SSE-C key, the SDK encodes it again and computes its MD5 when sending the request
+func expandObjectSSECustomerKey(rawKey string) (string, error) {
+	key, err := base64.StdEncoding.DecodeString(rawKey)
+	if err != nil {
+		return "", fmt.Errorf("sse_customer_key is not base64 encoded: %w", err)
+	}
+	if len(key) != 32 {
+		return "", fmt.Errorf("sse_customer_key must be a 256-bit key, got %d bits", len(key)*8)
+	}
+
+	return string(key), nil
+}
+
+// objectSSECustomerKeyMD5 returns the base64 encoded MD5 of a SSE-C key, as returned by the object storage.
+// It is the only value of the key stored in the state.
+func objectSSECustomerKeyMD5(rawKey string) string {
+	key, err := expandObjectSSECustomerKey(rawKey)
+	if err != nil {
+		return ""
+	}
+
+	sum := md5.Sum([]byte(key)) //nolint:gosec // SSE-C key digests are MD5 hashes
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func validateObjectSSECustomerKey() schema.SchemaValidateFunc {
+	return func(v interface{}, key string) ([]string, []error) {
+		if _, err := expandObjectSSECustomerKey(v.(string)); err != nil {
+			return nil, []error{err}
+		}
+
+		return nil, nil
+	}
+}
+
+// objectSSECustomerKeyFromConfig returns the decoded SSE-C key from the configuration, as the state only contains its MD5.
+// It returns nil when the configuration is not available, during a refresh or an import.
+func objectSSECustomerKeyFromConfig(d *schema.ResourceData) *string {
+	rawConfig := d.GetRawConfig()
+	if rawConfig.IsNull() || !rawConfig.IsKnown() {
+		return nil
+	}
+
+	rawKey := rawConfig.GetAttr("sse_customer_key")
+	if rawKey.IsNull() || !rawKey.IsKnown() || rawKey.AsString() == "" {
+		return nil
+	}
+
+	key, err := expandObjectSSECustomerKey(rawKey.AsString())
+	if err != nil {
+		return nil
+	}
+
+	return &key
+}
+
+// objectSSECustomerAlgorithm returns the algorithm to send along a SSE-C key
+func objectSSECustomerAlgorithm(key *string) *string {
+	if key == nil {
+		return nil
+	}
+
+	return scw.StringPtr(s3.ServerSideEncryptionAes256)
+}
+
+//gocyclo:ignore
+func expandObjectBucketLifecycleRules(lifecycleRules []interface{}) []*s3.LifecycleRule {
+	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRules))
+
+	for _, lifecycleRule := range lifecycleRules {
+		r := lifecycleRule.(map[string]interface{})
+
+		rule := &s3.LifecycleRule{}
+
+		// Filter
+		tags := expandObjectBucketTags(r["tags"])
+		filter := &s3.LifecycleRuleFilter{}
+		if len(tags) == 1 {
+			filter.SetTag(tags[0])
+		}
+		if len(tags) > 1 {
+			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
+			if len(r["prefix"].(string)) > 0 {
+				lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
+			}
+			lifecycleRuleAndOp.SetTags(tags)
+			filter.SetAnd(lifecycleRuleAndOp)
+		} else if len(r["prefix"].(string)) > 0 {
+			filter.SetPrefix(r["prefix"].(string))
+		}
+		rule.SetFilter(filter)
+
+		// ID
+		if val, ok := r["id"].(string); ok && val != "" {
+			rule.ID = aws.String(val)
+		} else {
+			rule.ID = aws.String(resource.PrefixedUniqueId("tf-scw-bucket-lifecycle-"))
+		}
+
+		// Enabled
+		if val, ok := r["enabled"].(bool); ok && val {
+			rule.Status = aws.String(s3.ExpirationStatusEnabled)
+		} else {
+			rule.Status = aws.String(s3.ExpirationStatusDisabled)
+		}
+
+		// AbortIncompleteMultipartUpload
+		if val, ok := r["abort_incomplete_multipart_upload_days"].(int); ok && val > 0 {
+			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(val)),
+			}
+		}
+
+		// Expiration
+		expiration := r["expiration"].([]interface{})
+		if len(expiration) > 0 && expiration[0] != nil {
+			e := expiration[0].(map[string]interface{})
+			i := &s3.LifecycleExpiration{}
+			if val, ok := e["days"].(int); ok && val > 0 {
+				i.Days = aws.Int64(int64(val))
+			}
+			rule.Expiration = i
+		}
+
+		// Transitions
+		transitions := r["transition"].(*schema.Set).List()
+		if len(transitions) > 0 {
+			rule.Transitions = make([]*s3.Transition, 0, len(transitions))
+			for _, transition := range transitions {
+				transition := transition.(map[string]interface{})
+				i := &s3.Transition{}
+				if val, ok := transition["days"].(int); ok && val >= 0 {
+					i.Days = aws.Int64(int64(val))
+				}
+				if val, ok := transition["storage_class"].(string); ok && val != "" {
+					i.StorageClass = aws.String(val)
+				}
+
+				rule.Transitions = append(rule.Transitions, i)
+			}
+		}
+
+		// As a lifecycle rule requires 1 or more transition/expiration actions,
+		// we explicitly pass a default ExpiredObjectDeleteMarker value to be able to create
+		// the rule while keeping the policy unaffected if the conditions are not met.
+		if rule.Expiration == nil && rule.NoncurrentVersionExpiration == nil &&
+			rule.Transitions == nil && rule.NoncurrentVersionTransitions == nil &&
+			rule.AbortIncompleteMultipartUpload == nil {
+			rule.Expiration = &s3.LifecycleExpiration{ExpiredObjectDeleteMarker: aws.Bool(false)}
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+//gocyclo:ignore
+func flattenObjectBucketLifecycleRules(rules []*s3.LifecycleRule) []map[string]interface{} {
+	lifecycleRules := make([]map[string]interface{}, 0)
+	if len(rules) > 0 {
+		lifecycleRules = make([]map[string]interface{}, 0, len(rules))
+
+		for _, lifecycleRule := range rules {
+			rule := make(map[string]interface{})
+
+			// ID
+			if lifecycleRule.ID != nil && aws.StringValue(lifecycleRule.ID) != "" {
+				rule["id"] = aws.StringValue(lifecycleRule.ID)
+			}
+			filter := lifecycleRule.Filter
+			if filter != nil {
+				if filter.And != nil {
+					// Prefix
+					if filter.And.Prefix != nil && aws.StringValue(filter.And.Prefix) != "" {
+						rule["prefix"] = aws.StringValue(filter.And.Prefix)
+					}
+					// Tag
+					if len(filter.And.Tags) > 0 {
+						rule["tags"] = flattenObjectBucketTags(filter.And.Tags)
+					}
+				} else {
+					// Prefix
+					if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
+						rule["prefix"] = aws.StringValue(filter.Prefix)
+					}
+					// Tag
+					if filter.Tag != nil {
+						rule["tags"] = flattenObjectBucketTags([]*s3.Tag{filter.Tag})
+					}
+				}
+			} else {
+				if lifecycleRule.Prefix != nil {
+					rule["prefix"] = aws.StringValue(lifecycleRule.Prefix)
+				}
+			}
+
+			// Enabled
+			if lifecycleRule.Status != nil {
+				if aws.StringValue(lifecycleRule.Status) == s3.ExpirationStatusEnabled {
+					rule["enabled"] = true
+				} else {
+					rule["enabled"] = false
+				}
+			}
+
+			// AbortIncompleteMultipartUploadDays
+			if lifecycleRule.AbortIncompleteMultipartUpload != nil {
+				if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
+					rule["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
+				}
+			}
+
+			// expiration
+			if lifecycleRule.Expiration != nil {
+				e := make(map[string]interface{})
+				if lifecycleRule.Expiration.Days != nil {
+					e["days"] = int(aws.Int64Value(lifecycleRule.Expiration.Days))
+				}
+				rule["expiration"] = []interface{}{e}
+			}
+			//// transition
+			if len(lifecycleRule.Transitions) > 0 {
+				transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
+				for _, v := range lifecycleRule.Transitions {
+					t := make(map[string]interface{})
+					if v.Days != nil {
+						t["days"] = int(aws.Int64Value(v.Days))
+					}
+					if v.StorageClass != nil {
+						t["storage_class"] = aws.StringValue(v.StorageClass)
+					}
+					transitions = append(transitions, t)
+				}
+				rule["transition"] = schema.NewSet(transitionHash, transitions)
+			}
+
+			lifecycleRules = append(lifecycleRules, rule)
+		}
+	}
+
+	return lifecycleRules
+}
+
+// objectBucketInlineConflictError rejects a standalone resource taking over an existing configuration,
+// which may be managed by the inline attribute of scaleway_object_bucket
+func objectBucketInlineConflictError(bucket string, configuration string, inlineAttribute string) error {
+	return fmt.Errorf("bucket %s already has a %s configuration, remove %s from its scaleway_object_bucket or import the existing configuration",
+		bucket, configuration, inlineAttribute)
+}
@@ -1,11 +1,25 @@
 package scaleway
 
 import (
+	"context"
+	"crypto/md5" //nolint:gosec // Object ETags are MD5 hashes
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestExpandObjectBucketTags(t *testing.T) {
@@ -48,3 +62,308 @@ func TestExpandObjectBucketTags(t *testing.T) {
 		})
 	}
 }
+
+func TestObjectBucketSyncLocalFiles(t *testing.T) {
+	sourceDir := t.TempDir()
+	for name, content := range map[string]string{
+		"index.html":       "<html></html>",
+		"css/main.css":     "body {}",
+		"js/app.js":        "console.log()",
+		"js/app.js.map":    "{}",
+		"drafts/post.html": "draft",
+	} {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	}
+
+	files, err := objectBucketSyncLocalFiles(sourceDir, "site/", nil, []string{"*.map", "drafts/*"})
+	require.NoError(t, err)
+
+	keys := []string(nil)
+	for key := range files {
+		keys = append(keys, key)
+	}
+	assert.ElementsMatch(t, []string{"site/index.html", "site/css/main.css", "site/js/app.js"}, keys)
+	assert.Equal(t, "fcdce6b6d6e2175f6406869882f6f1ce", files["site/css/main.css"].Hash)
+
+	files, err = objectBucketSyncLocalFiles(sourceDir, "", []string{"*.html"}, nil)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+	assert.Contains(t, files, "drafts/post.html")
+}
+
+func TestObjectBucketSyncHeaders(t *testing.T) {
+	contentTypes := map[string]interface{}{
+		".js": "application/javascript; charset=utf-8",
+	}
+	assert.Equal(t, "application/javascript; charset=utf-8", *objectBucketSyncContentType("site/app.js", contentTypes))
+	assert.Equal(t, "text/css; charset=utf-8", *objectBucketSyncContentType("site/main.css", contentTypes))
+	assert.Nil(t, objectBucketSyncContentType("site/LICENSE", contentTypes))
+
+	rules := []interface{}{
+		map[string]interface{}{"pattern": "*.html", "value": "no-cache"},
+		map[string]interface{}{"pattern": "assets/*", "value": "max-age=31536000"},
+	}
+	assert.Equal(t, "no-cache", *objectBucketSyncCacheControl("blog/index.html", rules))
+	assert.Equal(t, "max-age=31536000", *objectBucketSyncCacheControl("assets/logo.png", rules))
+	assert.Nil(t, objectBucketSyncCacheControl("robots.txt", rules))
+}
+
+func TestObjectMultipartPartSize(t *testing.T) {
+	assert.Equal(t, int64(minObjectMultipartPartSize), objectMultipartPartSize(100*1024*1024))
+	assert.Equal(t, int64(minObjectMultipartPartSize), objectMultipartPartSize(minObjectMultipartPartSize*(maxObjectMultipartParts-1)))
+
+	size := int64(100 * 1024 * 1024 * 1024)
+	partSize := objectMultipartPartSize(size)
+	assert.Equal(t, int64(20*1024*1024), partSize)
+	assert.Less(t, size/partSize, int64(maxObjectMultipartParts))
+}
+
+func TestObjectMultipartETag(t *testing.T) {
+	part1 := md5.Sum([]byte("hello "))
+	part2 := md5.Sum([]byte("world"))
+
+	assert.Equal(t, "e09e4fd6265b36115fe3db32df945d84-2", objectMultipartETag([][]byte{part1[:], part2[:]}))
+}
+
+func TestObjectFileMatchesETag(t *testing.T) {
+	content := make([]byte, minObjectMultipartPartSize+1)
+	for i := range content {
+		content[i] = byte(i)
+	}
+	filePath := filepath.Join(t.TempDir(), "file")
+	require.NoError(t, os.WriteFile(filePath, content, 0o600))
+
+	fileHash := md5.Sum(content)
+	part1 := md5.Sum(content[:minObjectMultipartPartSize])
+	part2 := md5.Sum(content[minObjectMultipartPartSize:])
+
+	for etag, expected := range map[string]bool{
+		hex.EncodeToString(fileHash[:]):                   true,
+		objectMultipartETag([][]byte{part1[:], part2[:]}): true,
+		objectMultipartETag([][]byte{fileHash[:]}):        false,
+		"d41d8cd98f00b204e9800998ecf8427e":                false,
+	} {
+		matches, err := objectFileMatchesETag(filePath, etag)
+		require.NoError(t, err)
+		assert.Equal(t, expected, matches, etag)
+	}
+}
+
+func TestObjectContentMatchesETag(t *testing.T) {
+	contentHash := md5.Sum([]byte("hello"))
+	etag := hex.EncodeToString(contentHash[:])
+
+	for _, tc := range []struct {
+		name     string
+		raw      map[string]interface{}
+		size     int64
+		expected bool
+	}{
+		{name: "content", raw: map[string]interface{}{"content": "hello"}, size: 5, expected: true},
+		{name: "content changed", raw: map[string]interface{}{"content": "hallo"}, size: 5, expected: false},
+		{name: "content size changed", raw: map[string]interface{}{"content": "hello"}, size: 6, expected: false},
+		{name: "content_base64", raw: map[string]interface{}{"content_base64": "aGVsbG8="}, size: 5, expected: true},
+		{name: "no content", raw: map[string]interface{}{}, size: 5, expected: false},
+		{name: "missing file", raw: map[string]interface{}{"file": filepath.Join(t.TempDir(), "missing")}, size: 5, expected: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceScalewayObject().Schema, tc.raw)
+			matches, err := objectContentMatchesETag(d, etag, tc.size)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, matches)
+		})
+	}
+}
+
+func TestObjectContent(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, resourceScalewayObject().Schema, map[string]interface{}{"content_base64": "aGk="})
+	content, err := objectContent(d)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hi"), content)
+
+	d = schema.TestResourceDataRaw(t, resourceScalewayObject().Schema, map[string]interface{}{"content": "hello"})
+	content, err = objectContent(d)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("hello"), content)
+}
+
+func TestObjectSSECustomerKey(t *testing.T) {
+	key, err := expandObjectSSECustomerKey("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY=")
+	require.NoError(t, err)
+	assert.Equal(t, "0123456789abcdef0123456789abcdef", key)
+	assert.Equal(t, "hRasmdxgYDKV3nvbahU1MA==", objectSSECustomerKeyMD5("MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="))
+
+	_, err = expandObjectSSECustomerKey("0123456789abcdef0123456789abcdef")
+	assert.Error(t, err)
+	_, err = expandObjectSSECustomerKey("c2hvcnQ=")
+	assert.ErrorContains(t, err, "256-bit")
+	assert.Equal(t, "", objectSSECustomerKeyMD5("c2hvcnQ="))
+}
+
+func TestObjectIdentifiersBatches(t *testing.T) {
+	objects := make([]*s3.ObjectIdentifier, 2500)
+	for i := range objects {
+		objects[i] = &s3.ObjectIdentifier{Key: scw.StringPtr("key"), VersionId: scw.StringPtr("version")}
+	}
+
+	batches := objectIdentifiersBatches(objects, maxObjectDeletionBatchSize)
+	require.Len(t, batches, 3)
+	assert.Len(t, batches[0], 1000)
+	assert.Len(t, batches[1], 1000)
+	assert.Len(t, batches[2], 500)
+
+	assert.Len(t, objectIdentifiersBatches(objects[:1000], maxObjectDeletionBatchSize), 1)
+	assert.Empty(t, objectIdentifiersBatches(nil, maxObjectDeletionBatchSize))
+}
+
+// fakeObjectVersionsS3 serves the object version listing and deletion requests of deleteS3ObjectVersions
+type fakeObjectVersionsS3 struct {
+	mu sync.Mutex
+	// pages of object version keys, each version ID is its key
+	pages [][]string
+	// legalHolds are the keys whose deletion is denied until their legal hold is removed
+	legalHolds map[string]bool
+	// failingDeletes are the keys whose deletion fails with an internal error
+	failingDeletes map[string]bool
+
+	listCalls    int
+	deleteCalls  [][]string
+	legalHoldOff []string
+}
+
+func (f *fakeObjectVersionsS3) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := req.Context().Err(); err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	query := req.URL.Query()
+	key := strings.TrimPrefix(req.URL.Path, "/")
+	switch {
+	case req.Method == http.MethodGet && query.Has("versions"):
+		f.listCalls++
+		page := 0
+		if marker := query.Get("key-marker"); marker != "" {
+			page, _ = strconv.Atoi(strings.TrimPrefix(marker, "page-"))
+		}
+		body := "<ListVersionsResult>"
+		if page+1 < len(f.pages) {
+			body += fmt.Sprintf("<IsTruncated>true</IsTruncated><NextKeyMarker>page-%d</NextKeyMarker><NextVersionIdMarker>v</NextVersionIdMarker>", page+1)
+		}
+		for _, versionKey := range f.pages[page] {
+			body += fmt.Sprintf("<Version><Key>%[1]s</Key><VersionId>%[1]s</VersionId></Version>", versionKey)
+		}
+
+		return fakeS3Response(req, http.StatusOK, body+"</ListVersionsResult>", nil), nil
+	case req.Method == http.MethodPost && query.Has("delete"):
+		deleteInput := struct {
+			Objects []struct {
+				Key string `xml:"Key"`
+			} `xml:"Object"`
+		}{}
+		if err := xml.NewDecoder(req.Body).Decode(&deleteInput); err != nil {
+			return nil, err
+		}
+
+		keys := []string(nil)
+		body := "<DeleteResult>"
+		for _, object := range deleteInput.Objects {
+			keys = append(keys, object.Key)
+			switch {
+			case f.failingDeletes[object.Key]:
+				body += fmt.Sprintf("<Error><Key>%[1]s</Key><VersionId>%[1]s</VersionId><Code>InternalError</Code><Message>failure</Message></Error>", object.Key)
+			case f.legalHolds[object.Key]:
+				body += fmt.Sprintf("<Error><Key>%[1]s</Key><VersionId>%[1]s</VersionId><Code>AccessDenied</Code><Message>legal hold</Message></Error>", object.Key)
+			}
+		}
+		f.deleteCalls = append(f.deleteCalls, keys)
+
+		return fakeS3Response(req, http.StatusOK, body+"</DeleteResult>", nil), nil
+	case req.Method == http.MethodHead:
+		header := http.Header{}
+		if f.legalHolds[key] {
+			header.Set("X-Amz-Object-Lock-Legal-Hold", s3.ObjectLockLegalHoldStatusOn)
+		}
+
+		return fakeS3Response(req, http.StatusOK, "", header), nil
+	case req.Method == http.MethodPut && query.Has("legal-hold"):
+		delete(f.legalHolds, key)
+		f.legalHoldOff = append(f.legalHoldOff, key)
+
+		return fakeS3Response(req, http.StatusOK, "", nil), nil
+	}
+
+	return nil, fmt.Errorf("unexpected request %s %s", req.Method, req.URL)
+}
+
+func fakeS3Response(req *http.Request, status int, body string, header http.Header) *http.Response {
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &http.Response{
+		StatusCode:    status,
+		Header:        header,
+		Body:          io.NopCloser(strings.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}
+}
+
+func newFakeObjectVersionsS3Client(t *testing.T, fake *fakeObjectVersionsS3) *s3.S3 {
+	// A custom CA bundle requires an *http.Transport
+	t.Setenv("AWS_CA_BUNDLE", "")
+	s3Client, err := newS3Client(&http.Client{Transport: fake}, "fr-par", "access-key", "secret-key")
+	require.NoError(t, err)
+
+	return s3Client
+}
+
+func TestDeleteS3ObjectVersionsBatchLegalHold(t *testing.T) {
+	fake := &fakeObjectVersionsS3{
+		legalHolds: map[string]bool{"locked": true},
+	}
+	s3Client := newFakeObjectVersionsS3Client(t, fake)
+	objects := []*s3.ObjectIdentifier{
+		{Key: scw.StringPtr("free"), VersionId: scw.StringPtr("free")},
+		{Key: scw.StringPtr("locked"), VersionId: scw.StringPtr("locked")},
+	}
+
+	deleted, err := deleteS3ObjectVersionsBatch(context.Background(), s3Client, "bucket", objects, true)
+	require.NoError(t, err)
+	assert.Equal(t, 2, deleted)
+	assert.Equal(t, []string{"locked"}, fake.legalHoldOff)
+	assert.Equal(t, [][]string{{"free", "locked"}, {"locked"}}, fake.deleteCalls)
+
+	// Without force, legal holds are kept and the versions are reported
+	fake = &fakeObjectVersionsS3{
+		legalHolds: map[string]bool{"locked": true},
+	}
+	s3Client = newFakeObjectVersionsS3Client(t, fake)
+
+	deleted, err = deleteS3ObjectVersionsBatch(context.Background(), s3Client, "bucket", objects, false)
+	assert.ErrorContains(t, err, "failed to delete S3 object locked")
+	assert.Equal(t, 1, deleted)
+	assert.Empty(t, fake.legalHoldOff)
+	assert.Len(t, fake.deleteCalls, 1)
+}
+
+func TestDeleteS3ObjectVersionsStopsListingOnFailure(t *testing.T) {
+	fake := &fakeObjectVersionsS3{
+		failingDeletes: map[string]bool{"key-0": true},
+	}
+	for i := 0; i < 50; i++ {
+		fake.pages = append(fake.pages, []string{fmt.Sprintf("key-%d", i)})
+	}
+	s3Client := newFakeObjectVersionsS3Client(t, fake)
+
+	err := deleteS3ObjectVersions(context.Background(), s3Client, "bucket", false, 1)
+	assert.ErrorContains(t, err, "failed to delete S3 object key-0")
+	// Only the pages listed before the failure was noticed are deleted
+	assert.Less(t, fake.listCalls, len(fake.pages))
+	assert.Less(t, len(fake.deleteCalls), len(fake.pages))
+}
@@ -2,14 +2,27 @@ package scaleway
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"path"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1alpha1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
@@ -66,6 +79,244 @@ func expandInstanceSettings(i interface{}) []*rdb.InstanceSetting {
 	return res
 }
 
+// rdbEngineVersion returns the engine version named name (e.g. PostgreSQL-15), or nil if it does not exist
+func rdbEngineVersion(ctx context.Context, api *rdb.API, region scw.Region, name string) (*rdb.EngineVersion, error) {
+	res, err := api.ListDatabaseEngines(&rdb.ListDatabaseEnginesRequest{
+		Region: region,
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, engine := range res.Engines {
+		for _, version := range engine.Versions {
+			if strings.EqualFold(version.Name, name) {
+				return version, nil
+			}
+		}
+	}
+
+	return nil, nil
+}
+
+func flattenRdbEngineSettings(settings []*rdb.EngineSetting) []map[string]interface{} {
+	res := []map[string]interface{}(nil)
+	for _, setting := range settings {
+		res = append(res, map[string]interface{}{
+			"name":              setting.Name,
+			"default_value":     setting.DefaultValue,
+			"hot_configurable":  setting.HotConfigurable,
+			"description":       setting.Description,
+			"property_type":     setting.PropertyType.String(),
+			"unit":              flattenStringPtr(setting.Unit),
+			"string_constraint": flattenStringPtr(setting.StringConstraint),
+			"int_min":           flattenInt32Ptr(setting.IntMin),
+			"int_max":           flattenInt32Ptr(setting.IntMax),
+			"float_min":         flattenFloat32Ptr(setting.FloatMin),
+			"float_max":         flattenFloat32Ptr(setting.FloatMax),
+		})
+	}
+
+	return res
+}
+
+// validateRdbEngineSetting checks that value matches the type and constraints of the engine setting
+func validateRdbEngineSetting(setting *rdb.EngineSetting, value string) error {
+	switch setting.PropertyType {
+	case rdb.EngineSettingPropertyTypeBOOLEAN:
+		if _, err := strconv.ParseBool(value); err != nil && !strings.EqualFold(value, "on") && !strings.EqualFold(value, "off") {
+			return fmt.Errorf("setting %s expects a boolean, got %q", setting.Name, value)
+		}
+	case rdb.EngineSettingPropertyTypeINT:
+		i, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("setting %s expects an integer, got %q", setting.Name, value)
+		}
+		if setting.IntMin != nil && i < int64(*setting.IntMin) {
+			return fmt.Errorf("setting %s must be at least %d, got %d", setting.Name, *setting.IntMin, i)
+		}
+		if setting.IntMax != nil && i > int64(*setting.IntMax) {
+			return fmt.Errorf("setting %s must be at most %d, got %d", setting.Name, *setting.IntMax, i)
+		}
+	case rdb.EngineSettingPropertyTypeFLOAT:
+		f, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return fmt.Errorf("setting %s expects a float, got %q", setting.Name, value)
+		}
+		if setting.FloatMin != nil && f < float64(*setting.FloatMin) {
+			return fmt.Errorf("setting %s must be at least %g, got %s", setting.Name, *setting.FloatMin, value)
+		}
+		if setting.FloatMax != nil && f > float64(*setting.FloatMax) {
+			return fmt.Errorf("setting %s must be at most %g, got %s", setting.Name, *setting.FloatMax, value)
+		}
+	case rdb.EngineSettingPropertyTypeSTRING:
+		if setting.StringConstraint != nil && *setting.StringConstraint != "" {
+			matched, err := regexp.MatchString(*setting.StringConstraint, value)
+			if err == nil && !matched {
+				return fmt.Errorf("setting %s must match %s, got %q", setting.Name, *setting.StringConstraint, value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// rdbChangedSettings returns the settings that are added or modified between two settings maps
+func rdbChangedSettings(oldRaw, newRaw interface{}) map[string]string {
+	oldSettings, _ := oldRaw.(map[string]interface{})
+	newSettings, _ := newRaw.(map[string]interface{})
+
+	changed := make(map[string]string)
+	for key, value := range newSettings {
+		if oldValue, ok := oldSettings[key]; !ok || oldValue != value {
+			changed[key] = value.(string)
+		}
+	}
+
+	return changed
+}
+
+// validateRdbInstanceSettings checks the changed settings against the ones available for the engine version
+func validateRdbInstanceSettings(changed map[string]string, available []*rdb.EngineSetting, engine string) error {
+	settings := make(map[string]*rdb.EngineSetting, len(available))
+	for _, setting := range available {
+		settings[setting.Name] = setting
+	}
+
+	keys := make([]string, 0, len(changed))
+	for key := range changed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errs *multierror.Error
+	for _, key := range keys {
+		setting, ok := settings[key]
+		if !ok {
+			errs = multierror.Append(errs, fmt.Errorf("setting %s is not available for engine %s", key, engine))
+			continue
+		}
+		errs = multierror.Append(errs, validateRdbEngineSetting(setting, changed[key]))
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// rdbSettingsRestartWarnings returns a warning for each changed setting that is not hot configurable
+func rdbSettingsRestartWarnings(changed map[string]string, available []*rdb.EngineSetting) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, setting := range available {
+		if _, ok := changed[setting.Name]; ok && !setting.HotConfigurable {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("setting %s requires a restart", setting.Name),
+				Detail:   "The database instance is restarted to apply this setting.",
+			})
+		}
+	}
+
+	return diags
+}
+
+func resourceScalewayRdbInstanceCustomizeDiffSettings(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.HasChanges("settings", "init_settings") || !diff.NewValueKnown("engine") ||
+		!diff.NewValueKnown("settings") || !diff.NewValueKnown("init_settings") {
+		return nil
+	}
+
+	oldSettings, newSettings := diff.GetChange("settings")
+	oldInitSettings, newInitSettings := diff.GetChange("init_settings")
+	changedSettings := rdbChangedSettings(oldSettings, newSettings)
+	changedInitSettings := rdbChangedSettings(oldInitSettings, newInitSettings)
+	if len(changedSettings) == 0 && len(changedInitSettings) == 0 {
+		return nil
+	}
+
+	region, err := extractRegion(diff, meta.(*Meta))
+	if err != nil {
+		return err
+	}
+
+	engine := diff.Get("engine").(string)
+	version, err := rdbEngineVersion(ctx, newRdbAPI(meta), region, engine)
+	if err != nil {
+		return err
+	}
+	// unknown engine versions are reported by the API on creation
+	if version == nil {
+		return nil
+	}
+
+	return multierror.Append(
+		validateRdbInstanceSettings(changedSettings, version.AvailableSettings, engine),
+		validateRdbInstanceSettings(changedInitSettings, version.AvailableInitSettings, engine),
+	).ErrorOrNil()
+}
+
+// resourceScalewayRdbInstanceCustomizeDiffClone checks that a cloned instance is configured like its source instance.
+// CloneInstance copies the engine, high availability, volume and init settings of the source,
+// a different configuration would plan a replacement or an update right after the clone.
+func resourceScalewayRdbInstanceCustomizeDiffClone(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() != "" || !diff.NewValueKnown("restore_from.0.source_instance_id") {
+		return nil
+	}
+	sourceInstanceID, ok := diff.GetOk("restore_from.0.source_instance_id")
+	if !ok {
+		return nil
+	}
+
+	region, ID, err := parseRegionalID(sourceInstanceID.(string))
+	if err != nil {
+		region, err = extractRegion(diff, meta.(*Meta))
+		if err != nil {
+			return err
+		}
+		ID = sourceInstanceID.(string)
+	}
+
+	source, err := newRdbAPI(meta).GetInstance(&rdb.GetInstanceRequest{
+		Region:     region,
+		InstanceID: ID,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return fmt.Errorf("failed to get source instance %s: %w", sourceInstanceID, err)
+	}
+
+	return validateRdbInstanceCloneSource(source, func(key string) (interface{}, bool) {
+		return diff.Get(key), diff.NewValueKnown(key)
+	})
+}
+
+// validateRdbInstanceCloneSource returns an error for each configured field that does not match the source instance.
+// get returns the configured value of a field and whether it is known.
+func validateRdbInstanceCloneSource(source *rdb.Instance, get func(key string) (interface{}, bool)) error {
+	var errs *multierror.Error
+	if engine, known := get("engine"); known && engine.(string) != source.Engine {
+		errs = multierror.Append(errs, fmt.Errorf("engine %q does not match the engine %q of the source instance", engine, source.Engine))
+	}
+	if isHaCluster, known := get("is_ha_cluster"); known && isHaCluster.(bool) != source.IsHaCluster {
+		errs = multierror.Append(errs, fmt.Errorf("is_ha_cluster must be %t like the source instance", source.IsHaCluster))
+	}
+	if source.Volume != nil {
+		if volumeType, known := get("volume_type"); known && volumeType.(string) != source.Volume.Type.String() {
+			errs = multierror.Append(errs, fmt.Errorf("volume_type %q does not match the volume type %q of the source instance", volumeType, source.Volume.Type))
+		}
+		// volume_size_in_gb is computed when not set
+		if size, known := get("volume_size_in_gb"); known && size.(int) != 0 && size.(int) != int(source.Volume.Size/scw.GB) {
+			errs = multierror.Append(errs, fmt.Errorf("volume_size_in_gb %d does not match the volume size %d of the source instance", size, int(source.Volume.Size/scw.GB)))
+		}
+	}
+	if rawInitSettings, known := get("init_settings"); known {
+		initSettings := flattenInstanceSettings(expandInstanceSettings(rawInitSettings)).(map[string]string)
+		sourceInitSettings := flattenInstanceSettings(source.InitSettings).(map[string]string)
+		if (len(initSettings) != 0 || len(sourceInitSettings) != 0) && !reflect.DeepEqual(initSettings, sourceInitSettings) {
+			errs = multierror.Append(errs, fmt.Errorf("init_settings %v do not match the init settings %v of the source instance", initSettings, sourceInitSettings))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 func waitForRDBInstance(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.Instance, error) {
 	retryInterval := defaultWaitRDBRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -94,6 +345,20 @@ func waitForRDBDatabaseBackup(ctx context.Context, api *rdb.API, region scw.Regi
 	}, scw.WithContext(ctx))
 }
 
+func waitForRDBInstanceLog(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.InstanceLog, error) {
+	retryInterval := defaultWaitRDBRetryInterval
+	if DefaultWaitRetryInterval != nil {
+		retryInterval = *DefaultWaitRetryInterval
+	}
+
+	return api.WaitForInstanceLog(&rdb.WaitForInstanceLogRequest{
+		Region:        region,
+		Timeout:       scw.TimeDurationPtr(timeout),
+		InstanceLogID: id,
+		RetryInterval: &retryInterval,
+	}, scw.WithContext(ctx))
+}
+
 func waitForRDBReadReplica(ctx context.Context, api *rdb.API, region scw.Region, id string, timeout time.Duration) (*rdb.ReadReplica, error) {
 	retryInterval := defaultWaitRDBRetryInterval
 	if DefaultWaitRetryInterval != nil {
@@ -108,6 +373,187 @@ func waitForRDBReadReplica(ctx context.Context, api *rdb.API, region scw.Region,
 	}, scw.WithContext(ctx))
 }
 
+// rdbInstanceConfigureClone applies the configuration that CloneInstance does not take on a freshly cloned instance
+func rdbInstanceConfigureClone(ctx context.Context, d *schema.ResourceData, api *rdb.API, region scw.Region, id string, createReq *rdb.CreateInstanceRequest) error {
+	_, err := waitForRDBInstance(ctx, api, region, id, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	updateReq := &rdb.UpdateInstanceRequest{
+		Region:                   region,
+		InstanceID:               id,
+		IsBackupScheduleDisabled: scw.BoolPtr(createReq.DisableBackup),
+	}
+	if createReq.Tags != nil {
+		updateReq.Tags = &createReq.Tags
+	}
+
+	_, err = api.UpdateInstance(updateReq, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	// users are cloned along with their password, the configured one is applied on top or created if missing from the source
+	if createReq.UserName != "" && createReq.Password != "" {
+		_, err = waitForRDBInstance(ctx, api, region, id, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+
+		users, err := api.ListUsers(&rdb.ListUsersRequest{
+			Region:     region,
+			InstanceID: id,
+			Name:       scw.StringPtr(createReq.UserName),
+		}, scw.WithContext(ctx), scw.WithAllPages())
+		if err != nil {
+			return err
+		}
+
+		userExists := false
+		for _, user := range users.Users {
+			if user.Name == createReq.UserName {
+				userExists = true
+				break
+			}
+		}
+
+		if userExists {
+			_, err = api.UpdateUser(&rdb.UpdateUserRequest{
+				Region:     region,
+				InstanceID: id,
+				Name:       createReq.UserName,
+				Password:   scw.StringPtr(createReq.Password),
+			}, scw.WithContext(ctx))
+		} else {
+			// the first user of an instance is an admin, as on CreateInstance
+			_, err = api.CreateUser(&rdb.CreateUserRequest{
+				Region:     region,
+				InstanceID: id,
+				Name:       createReq.UserName,
+				Password:   createReq.Password,
+				IsAdmin:    true,
+			}, scw.WithContext(ctx))
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if _, pnExist := d.GetOk("private_network"); pnExist {
+		for _, e := range createReq.InitEndpoints {
+			_, err = waitForRDBInstance(ctx, api, region, id, d.Timeout(schema.TimeoutCreate))
+			if err != nil {
+				return err
+			}
+
+			_, err = api.CreateEndpoint(&rdb.CreateEndpointRequest{
+				Region:       region,
+				InstanceID:   id,
+				EndpointSpec: e,
+			}, scw.WithContext(ctx))
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// rdbReadReplicaPromote promotes the read replica to a standalone instance and waits for the instance to be ready
+func rdbReadReplicaPromote(ctx context.Context, d *schema.ResourceData, api *rdb.API, region scw.Region, id string, timeout time.Duration) error {
+	instance, err := api.PromoteReadReplica(&rdb.PromoteReadReplicaRequest{
+		Region:        region,
+		ReadReplicaID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	_ = d.Set("promoted_instance_id", newRegionalIDString(region, instance.ID))
+
+	_, err = waitForRDBInstance(ctx, api, region, instance.ID, timeout)
+
+	return err
+}
+
+// rdbDatabaseBackupExportToBucket exports the backup and copies it to the bucket of export_to_bucket
+func rdbDatabaseBackupExportToBucket(ctx context.Context, d *schema.ResourceData, m interface{}, api *rdb.API, region scw.Region, id string, timeout time.Duration) error {
+	_, err := api.ExportDatabaseBackup(&rdb.ExportDatabaseBackupRequest{
+		Region:           region,
+		DatabaseBackupID: id,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+
+	backup, err := waitForRDBDatabaseBackup(ctx, api, region, id, timeout)
+	if err != nil {
+		return err
+	}
+	if backup.DownloadURL == nil {
+		return fmt.Errorf("backup %s has no download URL after export", id)
+	}
+
+	downloadURL, err := url.Parse(*backup.DownloadURL)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, downloadURL.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := m.(*Meta).httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("failed to download backup %s: %s", id, resp.Status)
+	}
+
+	s3Client, _, err := s3ClientWithRegion(d, m)
+	if err != nil {
+		return err
+	}
+
+	bucket := d.Get("export_to_bucket.0.bucket").(string)
+	key := d.Get("export_to_bucket.0.prefix").(string) + path.Base(downloadURL.Path)
+	body := &countingReader{reader: resp.Body}
+
+	_, err = s3manager.NewUploaderWithClient(s3Client).UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: scw.StringPtr(bucket),
+		Key:    scw.StringPtr(key),
+		Body:   body,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload backup %s to bucket %s: %w", id, bucket, err)
+	}
+
+	_ = d.Set("export_to_bucket", []map[string]interface{}{{
+		"bucket":      bucket,
+		"prefix":      d.Get("export_to_bucket.0.prefix"),
+		"object_key":  key,
+		"object_size": body.size,
+	}})
+
+	return nil
+}
+
+// countingReader counts the bytes read from the wrapped reader
+type countingReader struct {
+	reader io.Reader
+	size   int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.size += int64(n)
+	return n, err
+}
+
 func expandPrivateNetwork(data interface{}, exist bool) ([]*rdb.EndpointSpec, error) {
 	if data == nil || !exist {
 		return nil, nil
@@ -195,6 +641,22 @@ func flattenLoadBalancer(endpoints []*rdb.Endpoint) interface{} {
 	return flat
 }
 
+func flattenRdbInstanceLogs(logs []*rdb.InstanceLog) []map[string]interface{} {
+	res := []map[string]interface{}(nil)
+	for _, log := range logs {
+		res = append(res, map[string]interface{}{
+			"id":           newRegionalIDString(log.Region, log.ID),
+			"node_name":    log.NodeName,
+			"status":       log.Status.String(),
+			"download_url": flattenStringPtr(log.DownloadURL),
+			"created_at":   flattenTime(log.CreatedAt),
+			"expires_at":   flattenTime(log.ExpiresAt),
+		})
+	}
+
+	return res
+}
+
 // expandTimePtr returns a time pointer for an RFC3339 time.
 // It returns nil if time is not valid, you should use validateDate to validate field.
 func expandTimePtr(i interface{}) *time.Time {
@@ -321,3 +783,135 @@ func rdbPrivilegeUpgradeV1SchemaType() cty.Type {
 		"id": cty.String,
 	})
 }
+
+const rdbUserPasswordLength = 32
+
+var rdbUserPasswordCharsets = []string{
+	"abcdefghijklmnopqrstuvwxyz",
+	"ABCDEFGHIJKLMNOPQRSTUVWXYZ",
+	"0123456789",
+	"!#$%&*+-=?@^_",
+}
+
+// rdbUserGeneratePassword returns a random password containing at least one character of each charset
+func rdbUserGeneratePassword() (string, error) {
+	alphabet := strings.Join(rdbUserPasswordCharsets, "")
+	password := make([]byte, rdbUserPasswordLength)
+
+	for {
+		for i := range password {
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(alphabet))))
+			if err != nil {
+				return "", err
+			}
+			password[i] = alphabet[n.Int64()]
+		}
+
+		complete := true
+		for _, charset := range rdbUserPasswordCharsets {
+			if !strings.ContainsAny(string(password), charset) {
+				complete = false
+				break
+			}
+		}
+		if complete {
+			return string(password), nil
+		}
+	}
+}
+
+// rdbUserPasswordVersion is a secret version holding a generated password not yet accepted by the database
+type rdbUserPasswordVersion struct {
+	api      *secret.API
+	region   scw.Region
+	secretID string
+	version  *secret.SecretVersion
+	password string
+}
+
+// rdbUserCreatePasswordVersion generates a new password and writes it as the latest version of password_secret_id.
+// Previous versions stay enabled until rdbUserCommitPasswordVersion is called once the database accepted the password.
+func rdbUserCreatePasswordVersion(ctx context.Context, d *schema.ResourceData, m interface{}, region scw.Region) (*rdbUserPasswordVersion, error) {
+	password, err := rdbUserGeneratePassword()
+	if err != nil {
+		return nil, err
+	}
+
+	secretRegion, secretID, err := parseRegionalID(d.Get("password_secret_id").(string))
+	if err != nil {
+		secretRegion, secretID = region, d.Get("password_secret_id").(string)
+	}
+
+	api := secret.NewAPI(m.(*Meta).scwClient)
+	version, err := api.CreateSecretVersion(&secret.CreateSecretVersionRequest{
+		Region:      secretRegion,
+		SecretID:    secretID,
+		Data:        []byte(password),
+		Description: scw.StringPtr(fmt.Sprintf("password of rdb user %s", d.Get("name").(string))),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	return &rdbUserPasswordVersion{
+		api:      api,
+		region:   secretRegion,
+		secretID: secretID,
+		version:  version,
+		password: password,
+	}, nil
+}
+
+// rdbUserCommitPasswordVersion stores the revision of a password accepted by the database and disables the previous enabled versions
+func rdbUserCommitPasswordVersion(ctx context.Context, d *schema.ResourceData, v *rdbUserPasswordVersion) error {
+	_ = d.Set("password_secret_revision", int(v.version.Revision))
+	_ = d.Set("password_rotated_at", flattenTime(v.version.CreatedAt))
+
+	res, err := v.api.ListSecretVersions(&secret.ListSecretVersionsRequest{
+		Region:   v.region,
+		SecretID: v.secretID,
+		Status:   []secret.SecretVersionStatus{secret.SecretVersionStatusEnabled},
+	}, scw.WithContext(ctx), scw.WithAllPages())
+	if err != nil {
+		return err
+	}
+
+	for _, version := range res.Versions {
+		if version.Revision >= v.version.Revision {
+			continue
+		}
+		_, err := v.api.DisableSecretVersion(&secret.DisableSecretVersionRequest{
+			Region:   v.region,
+			SecretID: v.secretID,
+			Revision: strconv.FormatUint(uint64(version.Revision), 10),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// rdbUserRollbackPasswordVersion destroys a password version the database did not accept so that the previous version stays the latest enabled one
+func rdbUserRollbackPasswordVersion(ctx context.Context, v *rdbUserPasswordVersion, cause error) error {
+	_, err := v.api.DestroySecretVersion(&secret.DestroySecretVersionRequest{
+		Region:   v.region,
+		SecretID: v.secretID,
+		Revision: strconv.FormatUint(uint64(v.version.Revision), 10),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return multierror.Append(cause, fmt.Errorf("failed to destroy secret version %d holding the rejected password: %w", v.version.Revision, err))
+	}
+
+	return cause
+}
+
+// rdbUserPasswordCommitWarning reports previous password versions that could not be disabled, the new password being the latest enabled version
+func rdbUserPasswordCommitWarning(err error) diag.Diagnostics {
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  "previous password versions could not be disabled",
+		Detail:   err.Error(),
+	}}
+}
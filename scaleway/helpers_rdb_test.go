@@ -2,8 +2,19 @@ package scaleway
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	secret "github.com/scaleway/scaleway-sdk-go/api/secret/v1alpha1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
 )
 
 func TestRDBPrivilegeV1SchemaUpgradeFunc(t *testing.T) {
@@ -29,3 +40,186 @@ func TestRDBPrivilegeV1SchemaUpgradeFunc(t *testing.T) {
 		t.Fatalf("\n\nexpected:\n\n%#v\n\ngot:\n\n%#v\n\n", v1Schema, actual)
 	}
 }
+
+func TestRdbChangedSettings(t *testing.T) {
+	oldSettings := map[string]interface{}{
+		"work_mem":        "4",
+		"max_connections": "100",
+	}
+	newSettings := map[string]interface{}{
+		"work_mem":        "4",
+		"max_connections": "200",
+		"timezone":        "UTC",
+	}
+
+	expected := map[string]string{
+		"max_connections": "200",
+		"timezone":        "UTC",
+	}
+	if actual := rdbChangedSettings(oldSettings, newSettings); !reflect.DeepEqual(expected, actual) {
+		t.Fatalf("expected %v, got %v", expected, actual)
+	}
+}
+
+func TestValidateRdbInstanceSettings(t *testing.T) {
+	available := []*rdb.EngineSetting{
+		{Name: "max_connections", PropertyType: rdb.EngineSettingPropertyTypeINT, IntMin: scw.Int32Ptr(50), IntMax: scw.Int32Ptr(500)},
+		{Name: "autovacuum", PropertyType: rdb.EngineSettingPropertyTypeBOOLEAN},
+		{Name: "random_page_cost", PropertyType: rdb.EngineSettingPropertyTypeFLOAT, FloatMin: scw.Float32Ptr(1), FloatMax: scw.Float32Ptr(10)},
+		{Name: "timezone", PropertyType: rdb.EngineSettingPropertyTypeSTRING, StringConstraint: scw.StringPtr("^[A-Za-z/_]+$")},
+	}
+
+	tests := []struct {
+		name     string
+		settings map[string]string
+		wantErr  bool
+	}{
+		{"valid", map[string]string{"max_connections": "200", "autovacuum": "on", "random_page_cost": "1.5", "timezone": "Europe/Paris"}, false},
+		{"unknown setting", map[string]string{"not_a_setting": "1"}, true},
+		{"int not a number", map[string]string{"max_connections": "many"}, true},
+		{"int below min", map[string]string{"max_connections": "10"}, true},
+		{"int above max", map[string]string{"max_connections": "1000"}, true},
+		{"bool upper case on", map[string]string{"autovacuum": "ON"}, false},
+		{"bool upper case off", map[string]string{"autovacuum": "OFF"}, false},
+		{"bool mixed case", map[string]string{"autovacuum": "Off"}, false},
+		{"bool true", map[string]string{"autovacuum": "true"}, false},
+		{"bool invalid", map[string]string{"autovacuum": "maybe"}, true},
+		{"bool invalid upper case", map[string]string{"autovacuum": "ONN"}, true},
+		{"float above max", map[string]string{"random_page_cost": "11"}, true},
+		{"string constraint", map[string]string{"timezone": "UTC+1"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateRdbInstanceSettings(tt.settings, available, "PostgreSQL-15")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestRdbUserGeneratePassword(t *testing.T) {
+	password, err := rdbUserGeneratePassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(password) != rdbUserPasswordLength {
+		t.Fatalf("expected a %d characters password, got %d", rdbUserPasswordLength, len(password))
+	}
+	for _, charset := range rdbUserPasswordCharsets {
+		if !strings.ContainsAny(password, charset) {
+			t.Fatalf("expected password to contain one of %q", charset)
+		}
+	}
+
+	other, err := rdbUserGeneratePassword()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if password == other {
+		t.Fatal("expected two generated passwords to differ")
+	}
+}
+
+// fakeSecretVersions serves the secret version endpoints used to rotate rdb user passwords
+type fakeSecretVersions struct {
+	mu       sync.Mutex
+	statuses map[uint32]secret.SecretVersionStatus
+}
+
+func (f *fakeSecretVersions) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	w.Header().Set("Content-Type", "application/json")
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == http.MethodGet && parts[len(parts)-1] == "versions":
+		res := &secret.ListSecretVersionsResponse{}
+		for revision, status := range f.statuses {
+			if r.URL.Query().Get("status") == "" || r.URL.Query().Get("status") == string(status) {
+				res.Versions = append(res.Versions, &secret.SecretVersion{Revision: revision, Status: status})
+			}
+		}
+		res.TotalCount = uint32(len(res.Versions))
+		_ = json.NewEncoder(w).Encode(res)
+	case r.Method == http.MethodPost:
+		revision, _ := strconv.ParseUint(parts[len(parts)-2], 10, 32)
+		status := secret.SecretVersionStatusDisabled
+		if parts[len(parts)-1] == "destroy" {
+			status = secret.SecretVersionStatusDestroyed
+		}
+		f.statuses[uint32(revision)] = status
+		_ = json.NewEncoder(w).Encode(&secret.SecretVersion{Revision: uint32(revision), Status: status})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func newFakeRdbUserPasswordVersion(t *testing.T, fake *fakeSecretVersions, revision uint32) *rdbUserPasswordVersion {
+	t.Helper()
+	server := httptest.NewServer(fake)
+	t.Cleanup(server.Close)
+
+	client, err := scw.NewClient(scw.WithAPIURL(server.URL), scw.WithoutAuth(), scw.WithDefaultRegion(scw.RegionFrPar))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return &rdbUserPasswordVersion{
+		api:      secret.NewAPI(client),
+		region:   scw.RegionFrPar,
+		secretID: "11111111-1111-1111-1111-111111111111",
+		version:  &secret.SecretVersion{Revision: revision, Status: secret.SecretVersionStatusEnabled},
+		password: "password",
+	}
+}
+
+func TestRdbUserCommitPasswordVersion(t *testing.T) {
+	fake := &fakeSecretVersions{statuses: map[uint32]secret.SecretVersionStatus{
+		1: secret.SecretVersionStatusDisabled,
+		2: secret.SecretVersionStatusEnabled,
+		3: secret.SecretVersionStatusEnabled,
+	}}
+	v := newFakeRdbUserPasswordVersion(t, fake, 3)
+	d := resourceScalewayRdbUser().TestResourceData()
+
+	if err := rdbUserCommitPasswordVersion(context.Background(), d, v); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := map[uint32]secret.SecretVersionStatus{
+		1: secret.SecretVersionStatusDisabled,
+		2: secret.SecretVersionStatusDisabled,
+		3: secret.SecretVersionStatusEnabled,
+	}
+	if !reflect.DeepEqual(expected, fake.statuses) {
+		t.Fatalf("unexpected versions %v", fake.statuses)
+	}
+	if d.Get("password_secret_revision").(int) != 3 {
+		t.Fatalf("expected revision 3, got %d", d.Get("password_secret_revision").(int))
+	}
+}
+
+func TestRdbUserRollbackPasswordVersion(t *testing.T) {
+	fake := &fakeSecretVersions{statuses: map[uint32]secret.SecretVersionStatus{
+		1: secret.SecretVersionStatusEnabled,
+		2: secret.SecretVersionStatusEnabled,
+	}}
+	v := newFakeRdbUserPasswordVersion(t, fake, 2)
+	cause := errors.New("user rejected")
+
+	if err := rdbUserRollbackPasswordVersion(context.Background(), v, cause); !errors.Is(err, cause) {
+		t.Fatalf("expected the rdb error to be returned, got %v", err)
+	}
+
+	expected := map[uint32]secret.SecretVersionStatus{
+		1: secret.SecretVersionStatusEnabled,
+		2: secret.SecretVersionStatusDestroyed,
+	}
+	if !reflect.DeepEqual(expected, fake.statuses) {
+		t.Fatalf("unexpected versions %v", fake.statuses)
+	}
+}
@@ -58,6 +58,34 @@ func waitForRedisCluster(ctx context.Context, api *redis.API, zone scw.Zone, id
 	}, scw.WithContext(ctx))
 }
 
+// redisClusterMigrationDescription describes the change made by a migrate request
+func redisClusterMigrationDescription(req *redis.MigrateClusterRequest) string {
+	switch {
+	case req.ClusterSize != nil:
+		return fmt.Sprintf("scale the cluster to %d nodes", *req.ClusterSize)
+	case req.Version != nil:
+		return fmt.Sprintf("upgrade the cluster to version %s", *req.Version)
+	case req.NodeType != nil:
+		return fmt.Sprintf("change the cluster node type to %s", *req.NodeType)
+	}
+
+	return "migrate the cluster"
+}
+
+// customizeDiffRedisClusterSize rejects cluster size reductions, which the API does not support
+func customizeDiffRedisClusterSize(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("cluster_size") || !diff.NewValueKnown("cluster_size") {
+		return nil
+	}
+
+	oldSize, newSize := diff.GetChange("cluster_size")
+	if newSize.(int) != 0 && newSize.(int) < oldSize.(int) {
+		return fmt.Errorf("cluster_size cannot be reduced from %d to %d: redis clusters can only be scaled out", oldSize, newSize)
+	}
+
+	return nil
+}
+
 func expandRedisPrivateNetwork(data []interface{}) ([]*redis.EndpointSpec, error) {
 	if data == nil {
 		return nil, nil
@@ -84,103 +84,108 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 			},
 
 			ResourcesMap: map[string]*schema.Resource{
-				"scaleway_account_project":                     resourceScalewayAccountProject(),
-				"scaleway_account_ssh_key":                     resourceScalewayAccountSSKKey(),
-				"scaleway_apple_silicon_server":                resourceScalewayAppleSiliconServer(),
-				"scaleway_baremetal_server":                    resourceScalewayBaremetalServer(),
-				"scaleway_cockpit":                             resourceScalewayCockpit(),
-				"scaleway_cockpit_token":                       resourceScalewayCockpitToken(),
-				"scaleway_cockpit_grafana_user":                resourceScalewayCockpitGrafanaUser(),
-				"scaleway_container_namespace":                 resourceScalewayContainerNamespace(),
-				"scaleway_container_cron":                      resourceScalewayContainerCron(),
-				"scaleway_container_domain":                    resourceScalewayContainerDomain(),
-				"scaleway_container_trigger":                   resourceScalewayContainerTrigger(),
-				"scaleway_documentdb_instance":                 resourceScalewayDocumentDBInstance(),
-				"scaleway_documentdb_database":                 resourceScalewayDocumentDBDatabase(),
-				"scaleway_documentdb_private_network_endpoint": resourceScalewayDocumentDBInstancePrivateNetworkEndpoint(),
-				"scaleway_documentdb_user":                     resourceScalewayDocumentDBUser(),
-				"scaleway_documentdb_privilege":                resourceScalewayDocumentDBPrivilege(),
-				"scaleway_documentdb_read_replica":             resourceScalewayDocumentDBReadReplica(),
-				"scaleway_domain_record":                       resourceScalewayDomainRecord(),
-				"scaleway_domain_zone":                         resourceScalewayDomainZone(),
-				"scaleway_flexible_ip":                         resourceScalewayFlexibleIP(),
-				"scaleway_flexible_ip_mac_address":             resourceScalewayFlexibleIPMACAddress(),
-				"scaleway_function":                            resourceScalewayFunction(),
-				"scaleway_function_cron":                       resourceScalewayFunctionCron(),
-				"scaleway_function_domain":                     resourceScalewayFunctionDomain(),
-				"scaleway_function_namespace":                  resourceScalewayFunctionNamespace(),
-				"scaleway_function_token":                      resourceScalewayFunctionToken(),
-				"scaleway_function_trigger":                    resourceScalewayFunctionTrigger(),
-				"scaleway_iam_api_key":                         resourceScalewayIamAPIKey(),
-				"scaleway_iam_application":                     resourceScalewayIamApplication(),
-				"scaleway_iam_group":                           resourceScalewayIamGroup(),
-				"scaleway_iam_group_membership":                resourceScalewayIamGroupMembership(),
-				"scaleway_iam_policy":                          resourceScalewayIamPolicy(),
-				"scaleway_iam_user":                            resourceScalewayIamUser(),
-				"scaleway_instance_user_data":                  resourceScalewayInstanceUserData(),
-				"scaleway_instance_image":                      resourceScalewayInstanceImage(),
-				"scaleway_instance_ip":                         resourceScalewayInstanceIP(),
-				"scaleway_instance_ip_reverse_dns":             resourceScalewayInstanceIPReverseDNS(),
-				"scaleway_instance_volume":                     resourceScalewayInstanceVolume(),
-				"scaleway_instance_security_group":             resourceScalewayInstanceSecurityGroup(),
-				"scaleway_instance_security_group_rules":       resourceScalewayInstanceSecurityGroupRules(),
-				"scaleway_instance_server":                     resourceScalewayInstanceServer(),
-				"scaleway_instance_snapshot":                   resourceScalewayInstanceSnapshot(),
-				"scaleway_iam_ssh_key":                         resourceScalewayIamSSKKey(),
-				"scaleway_instance_placement_group":            resourceScalewayInstancePlacementGroup(),
-				"scaleway_instance_private_nic":                resourceScalewayInstancePrivateNIC(),
-				"scaleway_iot_hub":                             resourceScalewayIotHub(),
-				"scaleway_iot_device":                          resourceScalewayIotDevice(),
-				"scaleway_iot_route":                           resourceScalewayIotRoute(),
-				"scaleway_iot_network":                         resourceScalewayIotNetwork(),
-				"scaleway_ipam_ip":                             resourceScalewayIPAMIP(),
-				"scaleway_k8s_cluster":                         resourceScalewayK8SCluster(),
-				"scaleway_k8s_pool":                            resourceScalewayK8SPool(),
-				"scaleway_lb":                                  resourceScalewayLb(),
-				"scaleway_lb_acl":                              resourceScalewayLbACL(),
-				"scaleway_lb_ip":                               resourceScalewayLbIP(),
-				"scaleway_lb_backend":                          resourceScalewayLbBackend(),
-				"scaleway_lb_certificate":                      resourceScalewayLbCertificate(),
-				"scaleway_lb_frontend":                         resourceScalewayLbFrontend(),
-				"scaleway_lb_route":                            resourceScalewayLbRoute(),
-				"scaleway_registry_namespace":                  resourceScalewayRegistryNamespace(),
-				"scaleway_tem_domain":                          resourceScalewayTemDomain(),
-				"scaleway_container":                           resourceScalewayContainer(),
-				"scaleway_container_token":                     resourceScalewayContainerToken(),
-				"scaleway_rdb_acl":                             resourceScalewayRdbACL(),
-				"scaleway_rdb_database":                        resourceScalewayRdbDatabase(),
-				"scaleway_rdb_database_backup":                 resourceScalewayRdbDatabaseBackup(),
-				"scaleway_rdb_instance":                        resourceScalewayRdbInstance(),
-				"scaleway_rdb_privilege":                       resourceScalewayRdbPrivilege(),
-				"scaleway_rdb_user":                            resourceScalewayRdbUser(),
-				"scaleway_rdb_read_replica":                    resourceScalewayRdbReadReplica(),
-				"scaleway_redis_cluster":                       resourceScalewayRedisCluster(),
-				"scaleway_object":                              resourceScalewayObject(),
-				"scaleway_object_bucket":                       resourceScalewayObjectBucket(),
-				"scaleway_object_bucket_acl":                   resourceScalewayObjectBucketACL(),
-				"scaleway_object_bucket_lock_configuration":    resourceObjectLockConfiguration(),
-				"scaleway_object_bucket_policy":                resourceScalewayObjectBucketPolicy(),
-				"scaleway_object_bucket_website_configuration": ResourceBucketWebsiteConfiguration(),
-				"scaleway_mnq_nats_account":                    resourceScalewayMNQNatsAccount(),
-				"scaleway_mnq_nats_credentials":                resourceScalewayMNQNatsCredentials(),
-				"scaleway_mnq_sqs":                             resourceScalewayMNQSQS(),
-				"scaleway_mnq_sqs_queue":                       resourceScalewayMNQSQSQueue(),
-				"scaleway_mnq_sqs_credentials":                 resourceScalewayMNQSQSCredentials(),
-				"scaleway_mnq_namespace":                       resourceScalewayMNQNamespace(),
-				"scaleway_mnq_credential":                      resourceScalewayMNQCredential(),
-				"scaleway_mnq_queue":                           resourceScalewayMNQQueue(),
-				"scaleway_secret":                              resourceScalewaySecret(),
-				"scaleway_secret_version":                      resourceScalewaySecretVersion(),
-				"scaleway_vpc":                                 resourceScalewayVPC(),
-				"scaleway_vpc_public_gateway":                  resourceScalewayVPCPublicGateway(),
-				"scaleway_vpc_gateway_network":                 resourceScalewayVPCGatewayNetwork(),
-				"scaleway_vpc_public_gateway_dhcp":             resourceScalewayVPCPublicGatewayDHCP(),
-				"scaleway_vpc_public_gateway_dhcp_reservation": resourceScalewayVPCPublicGatewayDHCPReservation(),
-				"scaleway_vpc_public_gateway_ip":               resourceScalewayVPCPublicGatewayIP(),
-				"scaleway_vpc_public_gateway_ip_reverse_dns":   resourceScalewayVPCPublicGatewayIPReverseDNS(),
-				"scaleway_vpc_public_gateway_pat_rule":         resourceScalewayVPCPublicGatewayPATRule(),
-				"scaleway_vpc_private_network":                 resourceScalewayVPCPrivateNetwork(),
-				"scaleway_webhosting":                          resourceScalewayWebhosting(),
+				"scaleway_account_project":                       resourceScalewayAccountProject(),
+				"scaleway_account_ssh_key":                       resourceScalewayAccountSSKKey(),
+				"scaleway_apple_silicon_server":                  resourceScalewayAppleSiliconServer(),
+				"scaleway_baremetal_server":                      resourceScalewayBaremetalServer(),
+				"scaleway_cockpit":                               resourceScalewayCockpit(),
+				"scaleway_cockpit_token":                         resourceScalewayCockpitToken(),
+				"scaleway_cockpit_grafana_user":                  resourceScalewayCockpitGrafanaUser(),
+				"scaleway_container_namespace":                   resourceScalewayContainerNamespace(),
+				"scaleway_container_cron":                        resourceScalewayContainerCron(),
+				"scaleway_container_domain":                      resourceScalewayContainerDomain(),
+				"scaleway_container_trigger":                     resourceScalewayContainerTrigger(),
+				"scaleway_documentdb_instance":                   resourceScalewayDocumentDBInstance(),
+				"scaleway_documentdb_database":                   resourceScalewayDocumentDBDatabase(),
+				"scaleway_documentdb_private_network_endpoint":   resourceScalewayDocumentDBInstancePrivateNetworkEndpoint(),
+				"scaleway_documentdb_user":                       resourceScalewayDocumentDBUser(),
+				"scaleway_documentdb_privilege":                  resourceScalewayDocumentDBPrivilege(),
+				"scaleway_documentdb_read_replica":               resourceScalewayDocumentDBReadReplica(),
+				"scaleway_domain_record":                         resourceScalewayDomainRecord(),
+				"scaleway_domain_zone":                           resourceScalewayDomainZone(),
+				"scaleway_flexible_ip":                           resourceScalewayFlexibleIP(),
+				"scaleway_flexible_ip_mac_address":               resourceScalewayFlexibleIPMACAddress(),
+				"scaleway_function":                              resourceScalewayFunction(),
+				"scaleway_function_cron":                         resourceScalewayFunctionCron(),
+				"scaleway_function_domain":                       resourceScalewayFunctionDomain(),
+				"scaleway_function_namespace":                    resourceScalewayFunctionNamespace(),
+				"scaleway_function_token":                        resourceScalewayFunctionToken(),
+				"scaleway_function_trigger":                      resourceScalewayFunctionTrigger(),
+				"scaleway_iam_api_key":                           resourceScalewayIamAPIKey(),
+				"scaleway_iam_application":                       resourceScalewayIamApplication(),
+				"scaleway_iam_group":                             resourceScalewayIamGroup(),
+				"scaleway_iam_group_membership":                  resourceScalewayIamGroupMembership(),
+				"scaleway_iam_policy":                            resourceScalewayIamPolicy(),
+				"scaleway_iam_user":                              resourceScalewayIamUser(),
+				"scaleway_instance_user_data":                    resourceScalewayInstanceUserData(),
+				"scaleway_instance_image":                        resourceScalewayInstanceImage(),
+				"scaleway_instance_ip":                           resourceScalewayInstanceIP(),
+				"scaleway_instance_ip_reverse_dns":               resourceScalewayInstanceIPReverseDNS(),
+				"scaleway_instance_volume":                       resourceScalewayInstanceVolume(),
+				"scaleway_instance_security_group":               resourceScalewayInstanceSecurityGroup(),
+				"scaleway_instance_security_group_rules":         resourceScalewayInstanceSecurityGroupRules(),
+				"scaleway_instance_server":                       resourceScalewayInstanceServer(),
+				"scaleway_instance_snapshot":                     resourceScalewayInstanceSnapshot(),
+				"scaleway_iam_ssh_key":                           resourceScalewayIamSSKKey(),
+				"scaleway_instance_placement_group":              resourceScalewayInstancePlacementGroup(),
+				"scaleway_instance_private_nic":                  resourceScalewayInstancePrivateNIC(),
+				"scaleway_iot_hub":                               resourceScalewayIotHub(),
+				"scaleway_iot_device":                            resourceScalewayIotDevice(),
+				"scaleway_iot_route":                             resourceScalewayIotRoute(),
+				"scaleway_iot_network":                           resourceScalewayIotNetwork(),
+				"scaleway_ipam_ip":                               resourceScalewayIPAMIP(),
+				"scaleway_k8s_cluster":                           resourceScalewayK8SCluster(),
+				"scaleway_k8s_pool":                              resourceScalewayK8SPool(),
+				"scaleway_lb":                                    resourceScalewayLb(),
+				"scaleway_lb_acl":                                resourceScalewayLbACL(),
+				"scaleway_lb_ip":                                 resourceScalewayLbIP(),
+				"scaleway_lb_backend":                            resourceScalewayLbBackend(),
+				"scaleway_lb_certificate":                        resourceScalewayLbCertificate(),
+				"scaleway_lb_frontend":                           resourceScalewayLbFrontend(),
+				"scaleway_lb_route":                              resourceScalewayLbRoute(),
+				"scaleway_registry_namespace":                    resourceScalewayRegistryNamespace(),
+				"scaleway_tem_domain":                            resourceScalewayTemDomain(),
+				"scaleway_container":                             resourceScalewayContainer(),
+				"scaleway_container_token":                       resourceScalewayContainerToken(),
+				"scaleway_rdb_acl":                               resourceScalewayRdbACL(),
+				"scaleway_rdb_database":                          resourceScalewayRdbDatabase(),
+				"scaleway_rdb_database_backup":                   resourceScalewayRdbDatabaseBackup(),
+				"scaleway_rdb_instance":                          resourceScalewayRdbInstance(),
+				"scaleway_rdb_log_export":                        resourceScalewayRdbLogExport(),
+				"scaleway_rdb_privilege":                         resourceScalewayRdbPrivilege(),
+				"scaleway_rdb_user":                              resourceScalewayRdbUser(),
+				"scaleway_rdb_read_replica":                      resourceScalewayRdbReadReplica(),
+				"scaleway_redis_cluster":                         resourceScalewayRedisCluster(),
+				"scaleway_object":                                resourceScalewayObject(),
+				"scaleway_object_bucket":                         resourceScalewayObjectBucket(),
+				"scaleway_object_bucket_acl":                     resourceScalewayObjectBucketACL(),
+				"scaleway_object_bucket_cors_configuration":      resourceScalewayObjectBucketCORSConfiguration(),
+				"scaleway_object_bucket_lifecycle_configuration": resourceScalewayObjectBucketLifecycleConfiguration(),
+				"scaleway_object_bucket_lock_configuration":      resourceObjectLockConfiguration(),
+				"scaleway_object_bucket_policy":                  resourceScalewayObjectBucketPolicy(),
+				"scaleway_object_bucket_sync":                    resourceScalewayObjectBucketSync(),
+				"scaleway_object_bucket_versioning":              resourceScalewayObjectBucketVersioning(),
+				"scaleway_object_bucket_website_configuration":   ResourceBucketWebsiteConfiguration(),
+				"scaleway_mnq_nats_account":                      resourceScalewayMNQNatsAccount(),
+				"scaleway_mnq_nats_credentials":                  resourceScalewayMNQNatsCredentials(),
+				"scaleway_mnq_sqs":                               resourceScalewayMNQSQS(),
+				"scaleway_mnq_sqs_queue":                         resourceScalewayMNQSQSQueue(),
+				"scaleway_mnq_sqs_credentials":                   resourceScalewayMNQSQSCredentials(),
+				"scaleway_mnq_namespace":                         resourceScalewayMNQNamespace(),
+				"scaleway_mnq_credential":                        resourceScalewayMNQCredential(),
+				"scaleway_mnq_queue":                             resourceScalewayMNQQueue(),
+				"scaleway_secret":                                resourceScalewaySecret(),
+				"scaleway_secret_version":                        resourceScalewaySecretVersion(),
+				"scaleway_vpc":                                   resourceScalewayVPC(),
+				"scaleway_vpc_public_gateway":                    resourceScalewayVPCPublicGateway(),
+				"scaleway_vpc_gateway_network":                   resourceScalewayVPCGatewayNetwork(),
+				"scaleway_vpc_public_gateway_dhcp":               resourceScalewayVPCPublicGatewayDHCP(),
+				"scaleway_vpc_public_gateway_dhcp_reservation":   resourceScalewayVPCPublicGatewayDHCPReservation(),
+				"scaleway_vpc_public_gateway_ip":                 resourceScalewayVPCPublicGatewayIP(),
+				"scaleway_vpc_public_gateway_ip_reverse_dns":     resourceScalewayVPCPublicGatewayIPReverseDNS(),
+				"scaleway_vpc_public_gateway_pat_rule":           resourceScalewayVPCPublicGatewayPATRule(),
+				"scaleway_vpc_private_network":                   resourceScalewayVPCPrivateNetwork(),
+				"scaleway_webhosting":                            resourceScalewayWebhosting(),
 			},
 
 			DataSourcesMap: map[string]*schema.Resource{
@@ -228,8 +233,10 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_lbs":                                 dataSourceScalewayLbs(),
 				"scaleway_lb_acls":                             dataSourceScalewayLbACLs(),
 				"scaleway_lb_backend":                          dataSourceScalewayLbBackend(),
+				"scaleway_lb_backend_stats":                    dataSourceScalewayLbBackendStats(),
 				"scaleway_lb_backends":                         dataSourceScalewayLbBackends(),
 				"scaleway_lb_certificate":                      dataSourceScalewayLbCertificate(),
+				"scaleway_lb_config":                           dataSourceScalewayLbConfig(),
 				"scaleway_lb_frontend":                         dataSourceScalewayLbFrontend(),
 				"scaleway_lb_frontends":                        dataSourceScalewayLbFrontends(),
 				"scaleway_lb_ip":                               dataSourceScalewayLbIP(),
@@ -238,12 +245,18 @@ func Provider(config *ProviderConfig) plugin.ProviderFunc {
 				"scaleway_lb_routes":                           dataSourceScalewayLbRoutes(),
 				"scaleway_marketplace_image":                   dataSourceScalewayMarketplaceImage(),
 				"scaleway_mnq_sqs":                             dataSourceScalewayMNQSQS(),
+				"scaleway_object":                              dataSourceScalewayObject(),
 				"scaleway_object_bucket":                       dataSourceScalewayObjectBucket(),
 				"scaleway_object_bucket_policy":                dataSourceScalewayObjectBucketPolicy(),
+				"scaleway_object_bucket_usage":                 dataSourceScalewayObjectBucketUsage(),
+				"scaleway_object_presigned_url":                dataSourceScalewayObjectPresignedURL(),
+				"scaleway_objects":                             dataSourceScalewayObjects(),
 				"scaleway_rdb_acl":                             dataSourceScalewayRDBACL(),
 				"scaleway_rdb_instance":                        dataSourceScalewayRDBInstance(),
+				"scaleway_rdb_instance_logs":                   dataSourceScalewayRDBInstanceLogs(),
 				"scaleway_rdb_database":                        dataSourceScalewayRDBDatabase(),
 				"scaleway_rdb_database_backup":                 dataSourceScalewayRDBDatabaseBackup(),
+				"scaleway_rdb_engine_settings":                 dataSourceScalewayRDBEngineSettings(),
 				"scaleway_rdb_privilege":                       dataSourceScalewayRDBPrivilege(),
 				"scaleway_redis_cluster":                       dataSourceScalewayRedisCluster(),
 				"scaleway_registry_namespace":                  dataSourceScalewayRegistryNamespace(),
@@ -32,6 +32,7 @@ func resourceScalewayLbBackend() *schema.Resource {
 		StateUpgraders: []schema.StateUpgrader{
 			{Version: 0, Type: lbUpgradeV1SchemaType(), Upgrade: lbUpgradeV1SchemaUpgradeFunc},
 		},
+		CustomizeDiff: resourceScalewayLbBackendCustomizeDiff,
 		Schema: map[string]*schema.Schema{
 			"lb_id": {
 				Type:        schema.TypeString,
@@ -92,8 +93,39 @@ func resourceScalewayLbBackend() *schema.Resource {
 					Type:         schema.TypeString,
 					ValidateFunc: validation.IsIPAddress,
 				},
-				Optional:    true,
-				Description: "Backend server IP addresses list (IPv4 or IPv6)",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"server_selector"},
+				Description:   "Backend server IP addresses list (IPv4 or IPv6)",
+			},
+			"server_selector": {
+				Type:          schema.TypeList,
+				MaxItems:      1,
+				Optional:      true,
+				ConflictsWith: []string{"server_ips"},
+				Description:   "Select the backend servers from the instances attached to a private network",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"private_network_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validationUUIDorUUIDWithLocality(),
+							Description:  "The private network the instances are attached to",
+						},
+						"instance_tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Only select the instances having all these tags",
+						},
+						"triggers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Arbitrary values that resolve the selected servers again at apply time when they change",
+						},
+					},
+				},
 			},
 			"send_proxy_v2": {
 				Type:        schema.TypeBool,
@@ -340,6 +372,14 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 		healthCheckPort = d.Get("forward_port").(int)
 	}
 
+	serverIPs := expandStrings(d.Get("server_ips"))
+	if selector, ok := d.GetOk("server_selector"); ok {
+		serverIPs, err = lbBackendSelectServerIPs(ctx, meta, zone, selector)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = waitForLB(ctx, lbAPI, zone, lbID, d.Timeout(schema.TimeoutCreate))
 	if err != nil {
 		if is403Error(err) {
@@ -388,7 +428,7 @@ func resourceScalewayLbBackendCreate(ctx context.Context, d *schema.ResourceData
 			HTTPSConfig:     expandLbHCHTTPS(d.Get("health_check_https")),
 			CheckSendProxy:  d.Get("health_check_send_proxy").(bool),
 		},
-		ServerIP:              expandStrings(d.Get("server_ips")),
+		ServerIP:              serverIPs,
 		ProxyProtocol:         expandLbProxyProtocol(d.Get("proxy_protocol")),
 		TimeoutServer:         timeoutServer,
 		TimeoutConnect:        timeoutConnect,
@@ -626,10 +666,18 @@ func resourceScalewayLbBackendUpdate(ctx context.Context, d *schema.ResourceData
 	}
 
 	// Update Backend servers
+	serverIPs := expandStrings(d.Get("server_ips"))
+	if selector, ok := d.GetOk("server_selector"); ok {
+		serverIPs, err = lbBackendSelectServerIPs(ctx, meta, zone, selector)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	_, err = lbAPI.SetBackendServers(&lbSDK.ZonedAPISetBackendServersRequest{
 		Zone:      zone,
 		BackendID: ID,
-		ServerIP:  expandStrings(d.Get("server_ips")),
+		ServerIP:  serverIPs,
 	}, scw.WithContext(ctx))
 	if err != nil {
 		return diag.FromErr(err)
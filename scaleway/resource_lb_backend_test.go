@@ -426,6 +426,78 @@ func TestAccScalewayLbBackend_HealthCheck_Port(t *testing.T) {
 	})
 }
 
+func TestAccScalewayLbBackend_ServerSelector(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	config := func(serverCount int) string {
+		return fmt.Sprintf(`
+			resource scaleway_vpc_private_network pn01 {
+				name = "tf-test-lb-backend-selector"
+			}
+
+			resource scaleway_instance_server servers {
+				count = %d
+				type  = "PLAY2-PICO"
+				image = "ubuntu_jammy"
+				tags  = ["tf-test-lb-backend-selector"]
+				private_network {
+					pn_id = scaleway_vpc_private_network.pn01.id
+				}
+			}
+
+			resource scaleway_lb_ip ip01 {}
+			resource scaleway_lb lb01 {
+				ip_id = scaleway_lb_ip.ip01.id
+				name  = "test-lb-backend-selector"
+				type  = "lb-s"
+				private_network {
+					private_network_id = scaleway_vpc_private_network.pn01.id
+					dhcp_config        = true
+				}
+			}
+
+			resource scaleway_lb_backend bkd01 {
+				lb_id            = scaleway_lb.lb01.id
+				name             = "bkd01"
+				forward_protocol = "tcp"
+				forward_port     = 80
+				server_selector {
+					private_network_id = scaleway_vpc_private_network.pn01.id
+					instance_tags      = ["tf-test-lb-backend-selector"]
+					triggers = {
+						servers = join(",", scaleway_instance_server.servers[*].id)
+					}
+				}
+			}
+		`, serverCount)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayLbBackendDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config(1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayLbBackendExists(tt, "scaleway_lb_backend.bkd01"),
+					resource.TestCheckResourceAttr("scaleway_lb_backend.bkd01", "server_ips.#", "1"),
+				),
+			},
+			{
+				Config: config(2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayLbBackendExists(tt, "scaleway_lb_backend.bkd01"),
+					resource.TestCheckResourceAttr("scaleway_lb_backend.bkd01", "server_ips.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckScalewayLbBackendExists(tt *TestTools, n string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs, ok := state.RootModule().Resources[n]
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	lbSDK "github.com/scaleway/scaleway-sdk-go/api/lb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -17,6 +19,9 @@ func resourceScalewayLbCertificate() *schema.Resource {
 		ReadContext:   resourceScalewayLbCertificateRead,
 		UpdateContext: resourceScalewayLbCertificateUpdate,
 		DeleteContext: resourceScalewayLbCertificateDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceScalewayLbCertificateImport,
+		},
 		SchemaVersion: 1,
 		Timeouts: &schema.ResourceTimeout{
 			Create:  schema.DefaultTimeout(defaultLbLbTimeout),
@@ -85,6 +90,12 @@ func resourceScalewayLbCertificate() *schema.Resource {
 					},
 				},
 			},
+			"renew_before_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, maxLbCertificateRenewBeforeDays),
+				Description:  "Number of days before expiration from which the certificate should be renewed",
+			},
 
 			// Readonly attributes
 			"common_name": {
@@ -122,6 +133,7 @@ func resourceScalewayLbCertificate() *schema.Resource {
 				Description: "The status of certificate",
 			},
 		},
+		CustomizeDiff: resourceScalewayLbCertificateCustomizeDiff,
 	}
 }
 
@@ -215,9 +227,67 @@ func resourceScalewayLbCertificateRead(ctx context.Context, d *schema.ResourceDa
 			Detail:   errDetails,
 		})
 	}
+
+	if renewBeforeDays, ok := d.GetOk("renew_before_days"); ok && lbCertificateNeedsRenewal(certificate.NotValidAfter, renewBeforeDays.(int), time.Now()) {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("certificate %s expires on %s", certificate.ID, flattenTime(certificate.NotValidAfter)),
+			Detail:   fmt.Sprintf("The certificate is within its renewal window of %d days.", renewBeforeDays.(int)),
+		})
+	}
+
 	return diags
 }
 
+func resourceScalewayLbCertificateImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	certificate, err := lbAPI.GetCertificate(&lbSDK.ZonedAPIGetCertificateRequest{
+		CertificateID: ID,
+		Zone:          zone,
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	// The custom certificate chain is never returned by the API, only the let's encrypt configuration can be imported.
+	if certificate.Type == lbSDK.CertificateTypeLetsencryt {
+		_ = d.Set("letsencrypt", flattenLbLetsEncrypt(certificate))
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func resourceScalewayLbCertificateCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if diff.HasChange("custom_certificate.0.certificate_chain") && diff.NewValueKnown("custom_certificate.0.certificate_chain") {
+		if chain, ok := diff.GetOk("custom_certificate.0.certificate_chain"); ok {
+			// Invalid chains are left to the API validation
+			notValidAfter, err := lbCertificateChainNotValidAfter(chain.(string))
+			if err == nil && notValidAfter.Before(time.Now()) {
+				return fmt.Errorf("custom certificate expired on %s, please provide a valid certificate chain", notValidAfter.Format(time.RFC3339))
+			}
+		}
+	}
+
+	// A certificate due for renewal is re-issued in place, see resourceScalewayLbCertificateUpdate
+	letsencrypt := len(diff.Get("letsencrypt").([]interface{})) > 0
+	if diff.Id() == "" || !lbCertificateRenewalDue(letsencrypt, diff.Get("status").(string), expandTimePtr(diff.Get("not_valid_after")), diff.Get("renew_before_days").(int), time.Now()) {
+		return nil
+	}
+
+	for _, key := range []string{"fingerprint", "not_valid_before", "not_valid_after", "status"} {
+		err := diff.SetNewComputed(key)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	lbAPI, zone, ID, err := lbAPIWithZoneAndID(meta, d.Id())
 	if err != nil {
@@ -249,6 +319,23 @@ func resourceScalewayLbCertificateUpdate(ctx context.Context, d *schema.Resource
 		}
 	}
 
+	oldStatus, _ := d.GetChange("status")
+	oldNotValidAfter, _ := d.GetChange("not_valid_after")
+	letsencrypt := len(d.Get("letsencrypt").([]interface{})) > 0
+	if lbCertificateRenewalDue(letsencrypt, oldStatus.(string), expandTimePtr(oldNotValidAfter), d.Get("renew_before_days").(int), time.Now()) {
+		_, lbID, err := parseZonedID(d.Get("lb_id").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		certificate, err := lbCertificateReissue(ctx, lbAPI, zone, lbID, ID, d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(newZonedIDString(zone, certificate.ID))
+	}
+
 	return resourceScalewayLbCertificateRead(ctx, d, meta)
 }
 
@@ -3,11 +3,10 @@ package scaleway
 import (
 	"bytes"
 	"context"
-	"encoding/base64"
 	"fmt"
-	"os"
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -60,6 +59,24 @@ func resourceScalewayObject() *schema.Resource {
 				Description:   "Content of the file to upload, should be base64 encoded",
 				ConflictsWith: []string{"file", "content"},
 			},
+			"sse_customer_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ForceNew:     true,
+				Description:  "Customer's encryption key (base64 encoded 256-bit key), only its MD5 is stored in the state",
+				ValidateFunc: validateObjectSSECustomerKey(),
+				StateFunc: func(i interface{}) string {
+					return objectSSECustomerKeyMD5(i.(string))
+				},
+			},
+			"multipart_threshold": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      defaultObjectMultipartThreshold,
+				ValidateFunc: validation.IntAtLeast(minObjectMultipartPartSize),
+				Description:  "Size in bytes above which the file is uploaded in multiple parts",
+			},
 			"hash": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -116,38 +133,33 @@ func resourceScalewayObjectCreate(ctx context.Context, d *schema.ResourceData, m
 	bucket := d.Get("bucket").(string)
 	key := d.Get("key").(string)
 
+	sseCustomerKey := objectSSECustomerKeyFromConfig(d)
 	req := &s3.PutObjectInput{
-		ACL:          expandStringPtr(d.Get("visibility").(string)),
-		Bucket:       expandStringPtr(bucket),
-		Key:          expandStringPtr(key),
-		StorageClass: expandStringPtr(d.Get("storage_class")),
-		Metadata:     expandMapStringStringPtr(d.Get("metadata")),
+		ACL:                  expandStringPtr(d.Get("visibility").(string)),
+		Bucket:               expandStringPtr(bucket),
+		Key:                  expandStringPtr(key),
+		StorageClass:         expandStringPtr(d.Get("storage_class")),
+		Metadata:             expandMapStringStringPtr(d.Get("metadata")),
+		SSECustomerAlgorithm: objectSSECustomerAlgorithm(sseCustomerKey),
+		SSECustomerKey:       sseCustomerKey,
 	}
 
 	if filePath, hasFile := d.GetOk("file"); hasFile {
-		file, err := os.Open(filePath.(string))
+		err = uploadS3ObjectFile(ctx, s3Client, req, filePath.(string), int64(d.Get("multipart_threshold").(int)))
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		req.Body = file
-	} else if content, hasContent := d.GetOk("content"); hasContent {
-		contentString := []byte(content.(string))
-		req.Body = bytes.NewReader(contentString)
-	} else if content, hasContent := d.GetOk("content_base64"); hasContent {
-		contentString := []byte(content.(string))
-		decoded := make([]byte, base64.StdEncoding.DecodedLen(len(contentString)))
-		_, err = base64.StdEncoding.Decode(decoded, contentString)
+	} else {
+		content, err := objectContent(d)
 		if err != nil {
 			return diag.FromErr(err)
 		}
-		req.Body = bytes.NewReader(decoded)
-	} else {
-		req.Body = bytes.NewReader([]byte{})
-	}
+		req.Body = bytes.NewReader(content)
 
-	_, err = s3Client.PutObjectWithContext(ctx, req)
-	if err != nil {
-		return diag.FromErr(err)
+		_, err = s3Client.PutObjectWithContext(ctx, req)
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	if rawTags, hasTags := d.GetOk("tags"); hasTags {
@@ -177,33 +189,40 @@ func resourceScalewayObjectUpdate(ctx context.Context, d *schema.ResourceData, m
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
+	sseCustomerKey := objectSSECustomerKeyFromConfig(d)
 	if d.HasChanges("file", "hash") {
 		req := &s3.PutObjectInput{
-			Bucket:       expandStringPtr(d.Get("bucket")),
-			Key:          expandStringPtr(d.Get("key")),
-			StorageClass: expandStringPtr(d.Get("storage_class")),
-			Metadata:     expandMapStringStringPtr(d.Get("metadata")),
-			ACL:          expandStringPtr(d.Get("visibility").(string)),
+			Bucket:               expandStringPtr(d.Get("bucket")),
+			Key:                  expandStringPtr(d.Get("key")),
+			StorageClass:         expandStringPtr(d.Get("storage_class")),
+			Metadata:             expandMapStringStringPtr(d.Get("metadata")),
+			ACL:                  expandStringPtr(d.Get("visibility").(string)),
+			SSECustomerAlgorithm: objectSSECustomerAlgorithm(sseCustomerKey),
+			SSECustomerKey:       sseCustomerKey,
 		}
 
 		if filePath, hasFile := d.GetOk("file"); hasFile {
-			file, err := os.Open(filePath.(string))
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			req.Body = file
+			err = uploadS3ObjectFile(ctx, s3Client, req, filePath.(string), int64(d.Get("multipart_threshold").(int)))
 		} else {
-			req.Body = bytes.NewReader([]byte{})
+			var content []byte
+			content, err = objectContent(d)
+			if err == nil {
+				req.Body = bytes.NewReader(content)
+				_, err = s3Client.PutObjectWithContext(ctx, req)
+			}
 		}
-		_, err = s3Client.PutObjectWithContext(ctx, req)
 	} else {
 		_, err = s3Client.CopyObjectWithContext(ctx, &s3.CopyObjectInput{
-			Bucket:       expandStringPtr(d.Get("bucket")),
-			Key:          expandStringPtr(d.Get("key")),
-			StorageClass: expandStringPtr(d.Get("storage_class")),
-			CopySource:   scw.StringPtr(fmt.Sprintf("%s/%s", bucket, key)),
-			Metadata:     expandMapStringStringPtr(d.Get("metadata")),
-			ACL:          expandStringPtr(d.Get("visibility").(string)),
+			Bucket:                         expandStringPtr(d.Get("bucket")),
+			Key:                            expandStringPtr(d.Get("key")),
+			StorageClass:                   expandStringPtr(d.Get("storage_class")),
+			CopySource:                     scw.StringPtr(fmt.Sprintf("%s/%s", bucket, key)),
+			Metadata:                       expandMapStringStringPtr(d.Get("metadata")),
+			ACL:                            expandStringPtr(d.Get("visibility").(string)),
+			SSECustomerAlgorithm:           objectSSECustomerAlgorithm(sseCustomerKey),
+			SSECustomerKey:                 sseCustomerKey,
+			CopySourceSSECustomerAlgorithm: objectSSECustomerAlgorithm(sseCustomerKey),
+			CopySourceSSECustomerKey:       sseCustomerKey,
 		})
 	}
 	if err != nil {
@@ -247,25 +266,46 @@ func resourceScalewayObjectRead(ctx context.Context, d *schema.ResourceData, met
 	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
 	defer cancel()
 
-	obj, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
-		Bucket: expandStringPtr(bucket),
-		Key:    expandStringPtr(key),
-	})
-	if err != nil {
-		return diag.FromErr(err)
-	}
-
 	_ = d.Set("region", region)
 	_ = d.Set("bucket", bucket)
 	_ = d.Set("key", key)
 
-	for k, v := range obj.Metadata {
-		if k != strings.ToLower(k) {
-			obj.Metadata[strings.ToLower(k)] = v
-			delete(obj.Metadata, k)
+	// Objects encrypted with a customer key can only be read with the key, which is only known when the configuration is available.
+	// Otherwise the metadata and the key MD5 are kept from the state.
+	sseCustomerKey := objectSSECustomerKeyFromConfig(d)
+	if sseCustomerKey != nil || d.Get("sse_customer_key").(string) == "" {
+		obj, err := s3Client.HeadObjectWithContext(ctx, &s3.HeadObjectInput{
+			Bucket:               expandStringPtr(bucket),
+			Key:                  expandStringPtr(key),
+			SSECustomerAlgorithm: objectSSECustomerAlgorithm(sseCustomerKey),
+			SSECustomerKey:       sseCustomerKey,
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		for k, v := range obj.Metadata {
+			if k != strings.ToLower(k) {
+				obj.Metadata[strings.ToLower(k)] = v
+				delete(obj.Metadata, k)
+			}
+		}
+		_ = d.Set("metadata", flattenMapStringStringPtr(obj.Metadata))
+		_ = d.Set("sse_customer_key", flattenStringPtr(obj.SSECustomerKeyMD5))
+
+		// The ETag of objects encrypted with a customer key is not computed from their content.
+		// An object modified outside Terraform changes hash so that it is uploaded again.
+		if sseCustomerKey == nil {
+			etag := strings.Trim(aws.StringValue(obj.ETag), `"`)
+			matches, err := objectContentMatchesETag(d, etag, aws.Int64Value(obj.ContentLength))
+			if err != nil {
+				return diag.FromErr(err)
+			}
+			if !matches {
+				_ = d.Set("hash", etag)
+			}
 		}
 	}
-	_ = d.Set("metadata", flattenMapStringStringPtr(obj.Metadata))
 
 	tags, err := s3Client.GetObjectTaggingWithContext(ctx, &s3.GetObjectTaggingInput{
 		Bucket: expandStringPtr(bucket),
@@ -3,7 +3,6 @@ package scaleway
 import (
 	"context"
 	"fmt"
-	"log"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -12,7 +11,6 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
-	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/scw"
@@ -113,6 +111,12 @@ func resourceScalewayObjectBucket() *schema.Resource {
 				Default:     false,
 				Description: "Delete objects in bucket",
 			},
+			"force_destroy_workers": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Number of concurrent batch deletions used by force_destroy, defaults to the number of CPUs up to 8",
+				ValidateFunc: validation.IntBetween(1, 64),
+			},
 			"lifecycle_rule": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -286,7 +290,7 @@ func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceD
 	// Object Lock enables versioning so we don't want to update versioning it is enabled
 	objectLockEnabled := d.Get("object_lock_enabled").(bool)
 	if !objectLockEnabled && d.HasChange("versioning") {
-		if err := resourceScalewayObjectBucketVersioningUpdate(ctx, s3Client, d); err != nil {
+		if err := resourceScalewayS3BucketVersioningUpdate(ctx, s3Client, d); err != nil {
 			return diag.FromErr(err)
 		}
 	}
@@ -326,7 +330,6 @@ func resourceScalewayObjectBucketUpdate(ctx context.Context, d *schema.ResourceD
 	return resourceScalewayObjectBucketRead(ctx, d, meta)
 }
 
-//gocyclo:ignore
 func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.S3, d *schema.ResourceData) error {
 	bucket := d.Get("name").(string)
 
@@ -344,92 +347,7 @@ func resourceBucketLifecycleUpdate(ctx context.Context, conn *s3.S3, d *schema.R
 		return nil
 	}
 
-	rules := make([]*s3.LifecycleRule, 0, len(lifecycleRules))
-
-	for i, lifecycleRule := range lifecycleRules {
-		r := lifecycleRule.(map[string]interface{})
-
-		rule := &s3.LifecycleRule{}
-
-		// Filter
-		tags := expandObjectBucketTags(r["tags"])
-		filter := &s3.LifecycleRuleFilter{}
-		if len(tags) == 1 {
-			filter.SetTag(tags[0])
-		}
-		if len(tags) > 1 {
-			lifecycleRuleAndOp := &s3.LifecycleRuleAndOperator{}
-			if len(r["prefix"].(string)) > 0 {
-				lifecycleRuleAndOp.SetPrefix(r["prefix"].(string))
-			}
-			lifecycleRuleAndOp.SetTags(tags)
-			filter.SetAnd(lifecycleRuleAndOp)
-		} else if len(r["prefix"].(string)) > 0 {
-			filter.SetPrefix(r["prefix"].(string))
-		}
-		rule.SetFilter(filter)
-
-		// ID
-		if val, ok := r["id"].(string); ok && val != "" {
-			rule.ID = aws.String(val)
-		} else {
-			rule.ID = aws.String(resource.PrefixedUniqueId("tf-scw-bucket-lifecycle-"))
-		}
-
-		// Enabled
-		if val, ok := r["enabled"].(bool); ok && val {
-			rule.Status = aws.String(s3.ExpirationStatusEnabled)
-		} else {
-			rule.Status = aws.String(s3.ExpirationStatusDisabled)
-		}
-
-		// AbortIncompleteMultipartUpload
-		if val, ok := r["abort_incomplete_multipart_upload_days"].(int); ok && val > 0 {
-			rule.AbortIncompleteMultipartUpload = &s3.AbortIncompleteMultipartUpload{
-				DaysAfterInitiation: aws.Int64(int64(val)),
-			}
-		}
-
-		// Expiration
-		expiration := d.Get(fmt.Sprintf("lifecycle_rule.%d.expiration", i)).([]interface{})
-		if len(expiration) > 0 && expiration[0] != nil {
-			e := expiration[0].(map[string]interface{})
-			i := &s3.LifecycleExpiration{}
-			if val, ok := e["days"].(int); ok && val > 0 {
-				i.Days = aws.Int64(int64(val))
-			}
-			rule.Expiration = i
-		}
-
-		// Transitions
-		transitions := d.Get(fmt.Sprintf("lifecycle_rule.%d.transition", i)).(*schema.Set).List()
-		if len(transitions) > 0 {
-			rule.Transitions = make([]*s3.Transition, 0, len(transitions))
-			for _, transition := range transitions {
-				transition := transition.(map[string]interface{})
-				i := &s3.Transition{}
-				if val, ok := transition["days"].(int); ok && val >= 0 {
-					i.Days = aws.Int64(int64(val))
-				}
-				if val, ok := transition["storage_class"].(string); ok && val != "" {
-					i.StorageClass = aws.String(val)
-				}
-
-				rule.Transitions = append(rule.Transitions, i)
-			}
-		}
-
-		// As a lifecycle rule requires 1 or more transition/expiration actions,
-		// we explicitly pass a default ExpiredObjectDeleteMarker value to be able to create
-		// the rule while keeping the policy unaffected if the conditions are not met.
-		if rule.Expiration == nil && rule.NoncurrentVersionExpiration == nil &&
-			rule.Transitions == nil && rule.NoncurrentVersionTransitions == nil &&
-			rule.AbortIncompleteMultipartUpload == nil {
-			rule.Expiration = &s3.LifecycleExpiration{ExpiredObjectDeleteMarker: aws.Bool(false)}
-		}
-
-		rules = append(rules, rule)
-	}
+	rules := expandObjectBucketLifecycleRules(lifecycleRules)
 
 	i := &s3.PutBucketLifecycleConfigurationInput{
 		Bucket: aws.String(bucket),
@@ -562,88 +480,7 @@ func resourceScalewayObjectBucketRead(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(err)
 	}
 
-	lifecycleRules := make([]map[string]interface{}, 0)
-	if len(lifecycle.Rules) > 0 {
-		lifecycleRules = make([]map[string]interface{}, 0, len(lifecycle.Rules))
-
-		for _, lifecycleRule := range lifecycle.Rules {
-			log.Printf("[DEBUG] SCW bucket: %s, read lifecycle rule: %v", d.Id(), lifecycleRule)
-			rule := make(map[string]interface{})
-
-			// ID
-			if lifecycleRule.ID != nil && aws.StringValue(lifecycleRule.ID) != "" {
-				rule["id"] = aws.StringValue(lifecycleRule.ID)
-			}
-			filter := lifecycleRule.Filter
-			if filter != nil {
-				if filter.And != nil {
-					// Prefix
-					if filter.And.Prefix != nil && aws.StringValue(filter.And.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.And.Prefix)
-					}
-					// Tag
-					if len(filter.And.Tags) > 0 {
-						rule["tags"] = flattenObjectBucketTags(filter.And.Tags)
-					}
-				} else {
-					// Prefix
-					if filter.Prefix != nil && aws.StringValue(filter.Prefix) != "" {
-						rule["prefix"] = aws.StringValue(filter.Prefix)
-					}
-					// Tag
-					if filter.Tag != nil {
-						rule["tags"] = flattenObjectBucketTags([]*s3.Tag{filter.Tag})
-					}
-				}
-			} else {
-				if lifecycleRule.Prefix != nil {
-					rule["prefix"] = aws.StringValue(lifecycleRule.Prefix)
-				}
-			}
-
-			// Enabled
-			if lifecycleRule.Status != nil {
-				if aws.StringValue(lifecycleRule.Status) == s3.ExpirationStatusEnabled {
-					rule["enabled"] = true
-				} else {
-					rule["enabled"] = false
-				}
-			}
-
-			// AbortIncompleteMultipartUploadDays
-			if lifecycleRule.AbortIncompleteMultipartUpload != nil {
-				if lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation != nil {
-					rule["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(lifecycleRule.AbortIncompleteMultipartUpload.DaysAfterInitiation))
-				}
-			}
-
-			// expiration
-			if lifecycleRule.Expiration != nil {
-				e := make(map[string]interface{})
-				if lifecycleRule.Expiration.Days != nil {
-					e["days"] = int(aws.Int64Value(lifecycleRule.Expiration.Days))
-				}
-				rule["expiration"] = []interface{}{e}
-			}
-			//// transition
-			if len(lifecycleRule.Transitions) > 0 {
-				transitions := make([]interface{}, 0, len(lifecycleRule.Transitions))
-				for _, v := range lifecycleRule.Transitions {
-					t := make(map[string]interface{})
-					if v.Days != nil {
-						t["days"] = int(aws.Int64Value(v.Days))
-					}
-					if v.StorageClass != nil {
-						t["storage_class"] = aws.StringValue(v.StorageClass)
-					}
-					transitions = append(transitions, t)
-				}
-				rule["transition"] = schema.NewSet(transitionHash, transitions)
-			}
-
-			lifecycleRules = append(lifecycleRules, rule)
-		}
-	}
+	lifecycleRules := flattenObjectBucketLifecycleRules(lifecycle.Rules)
 	if err := d.Set("lifecycle_rule", lifecycleRules); err != nil {
 		return diag.FromErr(fmt.Errorf("error setting lifecycle_rule: %s", err))
 	}
@@ -667,7 +504,7 @@ func resourceScalewayObjectBucketDelete(ctx context.Context, d *schema.ResourceD
 
 	if isS3Err(err, ErrCodeBucketNotEmpty, "") {
 		if d.Get("force_destroy").(bool) {
-			err = deleteS3ObjectVersions(ctx, s3Client, bucketName, true)
+			err = deleteS3ObjectVersions(ctx, s3Client, bucketName, true, d.Get("force_destroy_workers").(int))
 			if err != nil {
 				return diag.FromErr(fmt.Errorf("error S3 bucket force_destroy: %s", err))
 			}
@@ -682,23 +519,11 @@ func resourceScalewayObjectBucketDelete(ctx context.Context, d *schema.ResourceD
 	return nil
 }
 
-func resourceScalewayObjectBucketVersioningUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
+func resourceScalewayS3BucketVersioningUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
 	v := d.Get("versioning").([]interface{})
 	bucketName := d.Get("name").(string)
-	vc := expandObjectBucketVersioning(v)
-
-	i := &s3.PutBucketVersioningInput{
-		Bucket:                  scw.StringPtr(bucketName),
-		VersioningConfiguration: vc,
-	}
-	tflog.Debug(ctx, fmt.Sprintf("S3 put bucket versioning: %#v", i))
 
-	_, err := s3conn.PutBucketVersioningWithContext(ctx, i)
-	if err != nil {
-		return fmt.Errorf("error putting S3 versioning: %s", err)
-	}
-
-	return nil
+	return resourceScalewayObjectBucketVersioningPut(ctx, s3conn, bucketName, expandObjectBucketVersioning(v))
 }
 
 func resourceScalewayS3BucketCorsUpdate(ctx context.Context, s3conn *s3.S3, d *schema.ResourceData) error {
@@ -0,0 +1,153 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayObjectBucketCORSConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayObjectBucketCORSConfigurationCreate,
+		ReadContext:   resourceScalewayObjectBucketCORSConfigurationRead,
+		UpdateContext: resourceScalewayObjectBucketCORSConfigurationUpdate,
+		DeleteContext: resourceScalewayObjectBucketCORSConfigurationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket name.",
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "CORS rules of the bucket",
+				Elem:        resourceScalewayObjectBucket().Schema["cors_rule"].Elem,
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayObjectBucketCORSConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := expandID(d.Get("bucket"))
+
+	existing, err := s3Client.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil && !isS3Err(err, ErrCodeNoSuchCORSConfiguration, "") {
+		return diag.FromErr(fmt.Errorf("error getting S3 Bucket CORS configuration: %s", err))
+	}
+	if err == nil && len(existing.CORSRules) > 0 {
+		return diag.FromErr(objectBucketInlineConflictError(bucket, "CORS", "cors_rule"))
+	}
+
+	if err := resourceScalewayObjectBucketCORSConfigurationPut(ctx, s3Client, bucket, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, bucket))
+
+	return resourceScalewayObjectBucketCORSConfigurationRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketCORSConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	corsResponse, err := s3Client.GetBucketCorsWithContext(ctx, &s3.GetBucketCorsInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if !d.IsNewResource() && tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration, s3.ErrCodeNoSuchBucket) {
+		tflog.Warn(ctx, fmt.Sprintf("[WARN] SCW Bucket CORS configuration (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting S3 Bucket CORS configuration: %s", err))
+	}
+
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("region", region)
+	_ = d.Set("cors_rule", flattenBucketCORS(corsResponse))
+
+	acl, err := s3Client.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+	}
+	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
+
+	return nil
+}
+
+func resourceScalewayObjectBucketCORSConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("cors_rule") {
+		if err := resourceScalewayObjectBucketCORSConfigurationPut(ctx, s3Client, bucket, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayObjectBucketCORSConfigurationRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketCORSConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tflog.Debug(ctx, fmt.Sprintf("S3 bucket: %s, delete CORS", bucket))
+	_, err = s3Client.DeleteBucketCorsWithContext(ctx, &s3.DeleteBucketCorsInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil && !tfawserr.ErrCodeEquals(err, ErrCodeNoSuchCORSConfiguration, s3.ErrCodeNoSuchBucket) {
+		return diag.FromErr(fmt.Errorf("error deleting S3 CORS: %s", err))
+	}
+
+	return nil
+}
+
+func resourceScalewayObjectBucketCORSConfigurationPut(ctx context.Context, s3Client *s3.S3, bucket string, d *schema.ResourceData) error {
+	corsInput := &s3.PutBucketCorsInput{
+		Bucket: scw.StringPtr(bucket),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: expandBucketCORS(ctx, d.Get("cors_rule").([]interface{}), bucket),
+		},
+	}
+	tflog.Debug(ctx, fmt.Sprintf("S3 bucket: %s, put CORS: %#v", bucket, corsInput))
+
+	_, err := s3Client.PutBucketCorsWithContext(ctx, corsInput)
+	if err != nil {
+		return fmt.Errorf("error putting S3 CORS: %s", err)
+	}
+
+	return nil
+}
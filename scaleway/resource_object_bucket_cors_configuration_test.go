@@ -0,0 +1,131 @@
+package scaleway
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayObjectBucketCORSConfiguration_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-bucket-cors")
+	resourceName := "scaleway_object_bucket_cors_configuration.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectBucketDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = %[1]q
+
+						lifecycle {
+							ignore_changes = [cors_rule]
+						}
+					}
+
+					resource "scaleway_object_bucket_cors_configuration" "main" {
+						bucket = scaleway_object_bucket.main.name
+
+						cors_rule {
+							allowed_headers = ["*"]
+							allowed_methods = ["PUT", "POST"]
+							allowed_origins = ["https://www.example.com"]
+							expose_headers  = ["ETag"]
+							max_age_seconds = 3000
+						}
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.max_age_seconds", "3000"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = %[1]q
+
+						lifecycle {
+							ignore_changes = [cors_rule]
+						}
+					}
+
+					resource "scaleway_object_bucket_cors_configuration" "main" {
+						bucket = scaleway_object_bucket.main.name
+
+						cors_rule {
+							allowed_methods = ["GET"]
+							allowed_origins = ["*"]
+						}
+
+						cors_rule {
+							allowed_methods = ["PUT"]
+							allowed_origins = ["https://www.example.com"]
+						}
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.0.allowed_methods.0", "GET"),
+					resource.TestCheckResourceAttr(resourceName, "cors_rule.1.allowed_origins.0", "https://www.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccScalewayObjectBucketCORSConfiguration_InlineConflict(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-bucket-cors")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectBucketDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = %[1]q
+
+						cors_rule {
+							allowed_methods = ["GET"]
+							allowed_origins = ["*"]
+						}
+					}
+
+					resource "scaleway_object_bucket_cors_configuration" "main" {
+						bucket = scaleway_object_bucket.main.name
+
+						cors_rule {
+							allowed_methods = ["GET"]
+							allowed_origins = ["*"]
+						}
+					}
+				`, bucketName),
+				ExpectError: regexp.MustCompile("already has a CORS configuration"),
+			},
+		},
+	})
+}
@@ -0,0 +1,160 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/aws-sdk-go-base/tfawserr"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayObjectBucketLifecycleConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayObjectBucketLifecycleConfigurationCreate,
+		ReadContext:   resourceScalewayObjectBucketLifecycleConfigurationRead,
+		UpdateContext: resourceScalewayObjectBucketLifecycleConfigurationUpdate,
+		DeleteContext: resourceScalewayObjectBucketLifecycleConfigurationDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket name.",
+			},
+			"lifecycle_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Lifecycle configuration is a set of rules that define actions that Scaleway Object Storage applies to a group of objects",
+				Elem:        resourceScalewayObjectBucket().Schema["lifecycle_rule"].Elem,
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayObjectBucketLifecycleConfigurationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := expandID(d.Get("bucket"))
+
+	existing, err := s3Client.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil && !tfawserr.ErrMessageContains(err, ErrCodeNoSuchLifecycleConfiguration, "") {
+		return diag.FromErr(fmt.Errorf("error getting Object Storage lifecycle: %s", err))
+	}
+	if err == nil && len(existing.Rules) > 0 {
+		return diag.FromErr(objectBucketInlineConflictError(bucket, "lifecycle", "lifecycle_rule"))
+	}
+
+	if err := resourceScalewayObjectBucketLifecycleConfigurationPut(ctx, s3Client, bucket, d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, bucket))
+
+	return resourceScalewayObjectBucketLifecycleConfigurationRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketLifecycleConfigurationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	lifecycle, err := s3Client.GetBucketLifecycleConfigurationWithContext(ctx, &s3.GetBucketLifecycleConfigurationInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if !d.IsNewResource() && (tfawserr.ErrMessageContains(err, ErrCodeNoSuchLifecycleConfiguration, "") || isS3Err(err, s3.ErrCodeNoSuchBucket, "")) {
+		tflog.Warn(ctx, fmt.Sprintf("[WARN] SCW Bucket lifecycle configuration (%s) not found, removing from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("error getting Object Storage lifecycle: %s", err))
+	}
+
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("region", region)
+	if err := d.Set("lifecycle_rule", flattenObjectBucketLifecycleRules(lifecycle.Rules)); err != nil {
+		return diag.FromErr(fmt.Errorf("error setting lifecycle_rule: %s", err))
+	}
+
+	acl, err := s3Client.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+	}
+	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
+
+	return nil
+}
+
+func resourceScalewayObjectBucketLifecycleConfigurationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("lifecycle_rule") {
+		if err := resourceScalewayObjectBucketLifecycleConfigurationPut(ctx, s3Client, bucket, d); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayObjectBucketLifecycleConfigurationRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketLifecycleConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = s3Client.DeleteBucketLifecycleWithContext(ctx, &s3.DeleteBucketLifecycleInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil && !isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+		return diag.FromErr(fmt.Errorf("error removing S3 lifecycle: %s", err))
+	}
+
+	return nil
+}
+
+func resourceScalewayObjectBucketLifecycleConfigurationPut(ctx context.Context, s3Client *s3.S3, bucket string, d *schema.ResourceData) error {
+	input := &s3.PutBucketLifecycleConfigurationInput{
+		Bucket: scw.StringPtr(bucket),
+		LifecycleConfiguration: &s3.BucketLifecycleConfiguration{
+			Rules: expandObjectBucketLifecycleRules(d.Get("lifecycle_rule").([]interface{})),
+		},
+	}
+
+	_, err := retryWhenAWSErrCodeEquals(ctx, []string{s3.ErrCodeNoSuchBucket}, &RetryWhenConfig[*s3.PutBucketLifecycleConfigurationOutput]{
+		Timeout:  d.Timeout(schema.TimeoutCreate),
+		Interval: 5 * time.Second,
+		Function: func() (*s3.PutBucketLifecycleConfigurationOutput, error) {
+			return s3Client.PutBucketLifecycleConfigurationWithContext(ctx, input)
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error putting Object Storage lifecycle: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package scaleway
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayObjectBucketLifecycleConfiguration_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-bucket-lifecycle")
+	resourceName := "scaleway_object_bucket_lifecycle_configuration.main"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectBucketDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = %[1]q
+
+						lifecycle {
+							ignore_changes = [lifecycle_rule]
+						}
+					}
+
+					resource "scaleway_object_bucket_lifecycle_configuration" "main" {
+						bucket = scaleway_object_bucket.main.name
+
+						lifecycle_rule {
+							id      = "archive"
+							prefix  = "logs/"
+							enabled = true
+
+							expiration {
+								days = 365
+							}
+
+							transition {
+								days          = 30
+								storage_class = "GLACIER"
+							}
+						}
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "bucket", bucketName),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.id", "archive"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.expiration.0.days", "365"),
+					resource.TestCheckTypeSetElemNestedAttrs(resourceName, "lifecycle_rule.0.transition.*", map[string]string{
+						"days":          "30",
+						"storage_class": "GLACIER",
+					}),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = %[1]q
+
+						lifecycle {
+							ignore_changes = [lifecycle_rule]
+						}
+					}
+
+					resource "scaleway_object_bucket_lifecycle_configuration" "main" {
+						bucket = scaleway_object_bucket.main.name
+
+						lifecycle_rule {
+							id      = "uploads"
+							enabled = true
+							abort_incomplete_multipart_upload_days = 7
+						}
+					}
+				`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.id", "uploads"),
+					resource.TestCheckResourceAttr(resourceName, "lifecycle_rule.0.abort_incomplete_multipart_upload_days", "7"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccScalewayObjectBucketLifecycleConfiguration_InlineConflict(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-bucket-lifecycle")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectBucketDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "scaleway_object_bucket" "main" {
+						name = %[1]q
+
+						lifecycle_rule {
+							id      = "uploads"
+							enabled = true
+							abort_incomplete_multipart_upload_days = 7
+						}
+					}
+
+					resource "scaleway_object_bucket_lifecycle_configuration" "main" {
+						bucket = scaleway_object_bucket.main.name
+
+						lifecycle_rule {
+							id      = "uploads"
+							enabled = true
+							abort_incomplete_multipart_upload_days = 7
+						}
+					}
+				`, bucketName),
+				ExpectError: regexp.MustCompile("already has a lifecycle configuration"),
+			},
+		},
+	})
+}
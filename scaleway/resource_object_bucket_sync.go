@@ -0,0 +1,459 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/scaleway/terraform-provider-scaleway/v2/internal"
+)
+
+const maxObjectBucketSyncWorkers = 8
+
+func resourceScalewayObjectBucketSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayObjectBucketSyncCreate,
+		ReadContext:   resourceScalewayObjectBucketSyncRead,
+		UpdateContext: resourceScalewayObjectBucketSyncUpdate,
+		DeleteContext: resourceScalewayObjectBucketSyncDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Create:  schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Read:    schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Update:  schema.DefaultTimeout(defaultObjectBucketTimeout),
+			Delete:  schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the bucket",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the local directory to upload",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix added to the keys of the uploaded objects",
+			},
+			"include": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Glob patterns of the files to upload, defaults to all files",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Glob patterns of the files to ignore",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Map of file extensions to content types, overriding the ones guessed from the extension",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"cache_control": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Cache-Control header rules, the first matching rule is applied",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pattern": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Glob pattern of the files",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of the Cache-Control header",
+						},
+					},
+				},
+			},
+			"visibility": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     s3.ObjectCannedACLPrivate,
+				Description: "Visibility of the uploaded objects, public-read or private",
+				ValidateFunc: validation.StringInSlice([]string{
+					s3.ObjectCannedACLPrivate,
+					s3.ObjectCannedACLPublicRead,
+				}, false),
+			},
+			"delete_extraneous": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix that do not exist in the source directory",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the synced object keys to their ETag",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"added_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects added by the last sync",
+			},
+			"changed_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects updated by the last sync",
+			},
+			"removed_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of objects deleted by the last sync",
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+		CustomizeDiff: resourceScalewayObjectBucketSyncCustomizeDiff,
+	}
+}
+
+func resourceScalewayObjectBucketSyncCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+
+	// Objects already under the prefix are tracked so that the extraneous ones are deleted by the first sync
+	currentFiles := map[string]interface{}{}
+	if d.Get("delete_extraneous").(bool) {
+		currentFiles, err = objectBucketSyncRemoteFiles(ctx, s3Client, bucket, prefix, func(rel string) bool {
+			return objectBucketSyncIsManaged(rel, expandStrings(d.Get("include")), expandStrings(d.Get("exclude")))
+		})
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed listing objects in bucket %s: %w", bucket, err))
+		}
+	}
+
+	removed, err := objectBucketSync(ctx, s3Client, d, currentFiles, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, objectID(bucket, prefix)))
+	_ = d.Set("removed_count", removed)
+
+	return resourceScalewayObjectBucketSyncRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketSyncRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutRead))
+	defer cancel()
+
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	include := expandStrings(d.Get("include"))
+	exclude := expandStrings(d.Get("exclude"))
+	syncedFiles := d.Get("files").(map[string]interface{})
+	deleteExtraneous := d.Get("delete_extraneous").(bool)
+
+	// Synced objects are refreshed from the bucket so that objects modified or deleted outside Terraform are uploaded again.
+	// When extraneous objects are deleted, every object matching the filters under the prefix is tracked to show up as removed.
+	files, err := objectBucketSyncRemoteFiles(ctx, s3Client, bucket, prefix, func(rel string) bool {
+		_, synced := syncedFiles[prefix+rel]
+		return synced || (deleteExtraneous && objectBucketSyncIsManaged(rel, include, exclude))
+	})
+	if err != nil {
+		if isS3Err(err, s3.ErrCodeNoSuchBucket, "") && !d.IsNewResource() {
+			tflog.Warn(ctx, fmt.Sprintf("bucket %s not found, removing sync from state", bucket))
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("failed listing objects in bucket %s: %w", bucket, err))
+	}
+
+	_ = d.Set("files", files)
+	_ = d.Set("region", region)
+
+	return nil
+}
+
+func resourceScalewayObjectBucketSyncUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	oldFiles, _ := d.GetChange("files")
+	uploadAll := d.HasChanges("content_types", "cache_control", "visibility")
+	_, err = objectBucketSync(ctx, s3Client, d, oldFiles.(map[string]interface{}), uploadAll)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceScalewayObjectBucketSyncRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketSyncDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	bucket := d.Get("bucket").(string)
+	pool := internal.NewWorkerPool(maxObjectBucketSyncWorkers)
+	for key := range d.Get("files").(map[string]interface{}) {
+		key := key
+		pool.AddTask(func() error {
+			_, err := s3Client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+				Bucket: scw.StringPtr(bucket),
+				Key:    scw.StringPtr(key),
+			})
+			if err != nil && !isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+				return fmt.Errorf("failed to delete object %s: %w", key, err)
+			}
+
+			return nil
+		})
+	}
+
+	if errs := pool.CloseAndWait(); len(errs) > 0 {
+		return diag.FromErr(multierror.Append(nil, errs...))
+	}
+
+	return nil
+}
+
+// resourceScalewayObjectBucketSyncCustomizeDiff hashes the local files and plans the objects to add, change and remove
+func resourceScalewayObjectBucketSyncCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	for _, key := range []string{"source_dir", "prefix", "include", "exclude", "delete_extraneous"} {
+		if !diff.NewValueKnown(key) {
+			for _, computedKey := range []string{"files", "added_count", "changed_count", "removed_count"} {
+				if err := diff.SetNewComputed(computedKey); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}
+
+	localFiles, err := objectBucketSyncLocalFiles(
+		diff.Get("source_dir").(string),
+		diff.Get("prefix").(string),
+		expandStrings(diff.Get("include")),
+		expandStrings(diff.Get("exclude")),
+	)
+	if err != nil {
+		return err
+	}
+
+	oldFiles, _ := diff.GetChange("files")
+	currentFiles := oldFiles.(map[string]interface{})
+	uploadAll := diff.Id() != "" && (diff.HasChange("content_types") || diff.HasChange("cache_control") || diff.HasChange("visibility"))
+
+	files := make(map[string]interface{}, len(localFiles))
+	added, changed, removed := 0, 0, 0
+	for key, file := range localFiles {
+		files[key] = file.Hash
+		currentETag, exists := currentFiles[key]
+		if !exists {
+			added++
+			continue
+		}
+
+		upToDate, err := objectBucketSyncFileIsUpToDate(file, currentETag.(string))
+		if err != nil {
+			return err
+		}
+		// Objects uploaded in multiple parts keep their ETag when their content is up-to-date
+		if upToDate && !uploadAll {
+			files[key] = currentETag
+		} else {
+			changed++
+		}
+	}
+	if diff.Get("delete_extraneous").(bool) {
+		for key := range currentFiles {
+			if _, exists := localFiles[key]; !exists {
+				removed++
+			}
+		}
+	}
+
+	if diff.Id() != "" && added == 0 && changed == 0 && removed == 0 && len(files) == len(currentFiles) {
+		return nil
+	}
+
+	if err := diff.SetNew("files", files); err != nil {
+		return err
+	}
+	if err := diff.SetNew("added_count", added); err != nil {
+		return err
+	}
+	if err := diff.SetNew("changed_count", changed); err != nil {
+		return err
+	}
+
+	// The objects deleted on creation are only known once the bucket is listed
+	if diff.Id() == "" && diff.Get("delete_extraneous").(bool) {
+		return diff.SetNewComputed("removed_count")
+	}
+
+	return diff.SetNew("removed_count", removed)
+}
+
+// objectBucketSync uploads the local files missing or outdated in currentFiles and deletes the extraneous ones.
+// It returns the number of deleted objects.
+func objectBucketSync(ctx context.Context, s3Client *s3.S3, d *schema.ResourceData, currentFiles map[string]interface{}, uploadAll bool) (int, error) {
+	bucket := d.Get("bucket").(string)
+	prefix := d.Get("prefix").(string)
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	cacheControl := d.Get("cache_control").([]interface{})
+	visibility := d.Get("visibility").(string)
+
+	localFiles, err := objectBucketSyncLocalFiles(
+		d.Get("source_dir").(string),
+		prefix,
+		expandStrings(d.Get("include")),
+		expandStrings(d.Get("exclude")),
+	)
+	if err != nil {
+		return 0, err
+	}
+
+	pool := internal.NewWorkerPool(maxObjectBucketSyncWorkers)
+	for key, file := range localFiles {
+		if currentETag, exists := currentFiles[key]; exists && !uploadAll {
+			upToDate, err := objectBucketSyncFileIsUpToDate(file, currentETag.(string))
+			if err != nil {
+				return 0, err
+			}
+			if upToDate {
+				continue
+			}
+		}
+
+		key, file := key, file
+		pool.AddTask(func() error {
+			body, err := os.Open(file.Path)
+			if err != nil {
+				return err
+			}
+			defer body.Close()
+
+			_, err = s3Client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+				Bucket:       scw.StringPtr(bucket),
+				Key:          scw.StringPtr(key),
+				Body:         body,
+				ACL:          scw.StringPtr(visibility),
+				ContentType:  objectBucketSyncContentType(key, contentTypes),
+				CacheControl: objectBucketSyncCacheControl(strings.TrimPrefix(key, prefix), cacheControl),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to upload %s to object %s: %w", file.Path, key, err)
+			}
+
+			return nil
+		})
+	}
+
+	removed := 0
+	if d.Get("delete_extraneous").(bool) {
+		for key := range currentFiles {
+			if _, exists := localFiles[key]; exists {
+				continue
+			}
+
+			removed++
+			key := key
+			pool.AddTask(func() error {
+				_, err := s3Client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+					Bucket: scw.StringPtr(bucket),
+					Key:    scw.StringPtr(key),
+				})
+				if err != nil {
+					return fmt.Errorf("failed to delete object %s: %w", key, err)
+				}
+
+				return nil
+			})
+		}
+	}
+
+	if errs := pool.CloseAndWait(); len(errs) > 0 {
+		return 0, multierror.Append(nil, errs...)
+	}
+
+	return removed, nil
+}
+
+// objectBucketSyncRemoteFiles lists the objects under the prefix and returns the ETag of the tracked ones by key.
+// tracked is called with the key relative to the prefix.
+func objectBucketSyncRemoteFiles(ctx context.Context, s3Client *s3.S3, bucket string, prefix string, tracked func(rel string) bool) (map[string]interface{}, error) {
+	files := make(map[string]interface{})
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, &s3.ListObjectsV2Input{
+		Bucket: scw.StringPtr(bucket),
+		Prefix: scw.StringPtr(prefix),
+	}, func(page *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range page.Contents {
+			key := aws.StringValue(object.Key)
+			if tracked(strings.TrimPrefix(key, prefix)) {
+				files[key] = strings.Trim(aws.StringValue(object.ETag), `"`)
+			}
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// objectBucketSyncFileIsUpToDate returns whether the object with the given ETag has the content of the local file
+func objectBucketSyncFileIsUpToDate(file objectBucketSyncFile, etag string) (bool, error) {
+	if !strings.Contains(etag, "-") {
+		return etag == file.Hash, nil
+	}
+
+	return objectFileMatchesETag(file.Path, etag)
+}
@@ -0,0 +1,178 @@
+package scaleway
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/s3"
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccScalewayObjectBucketSync_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-bucket-sync")
+
+	sourceDir := t.TempDir()
+	writeFile := func(name string, content string) {
+		filePath := filepath.Join(sourceDir, filepath.FromSlash(name))
+		require.NoError(t, os.MkdirAll(filepath.Dir(filePath), 0o755))
+		require.NoError(t, os.WriteFile(filePath, []byte(content), 0o600))
+	}
+	writeFile("index.html", "<html>v1</html>")
+	writeFile("css/main.css", "body {}")
+	writeFile("notes.tmp", "ignored")
+
+	config := fmt.Sprintf(`
+		resource "scaleway_object_bucket" "main" {
+			name = "%s"
+		}
+
+		resource "scaleway_object_bucket_sync" "site" {
+			bucket            = scaleway_object_bucket.main.name
+			source_dir        = "%s"
+			exclude           = ["*.tmp"]
+			delete_extraneous = true
+			visibility        = "public-read"
+
+			cache_control {
+				pattern = "*.html"
+				value   = "no-cache"
+			}
+		}
+	`, bucketName, filepath.ToSlash(sourceDir))
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectBucketDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("scaleway_object_bucket_sync.site", "files.index.html"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "added_count", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html>v2</html>")
+					writeFile("js/app.js", "console.log()")
+					require.NoError(t, os.Remove(filepath.Join(sourceDir, "css", "main.css")))
+				},
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("scaleway_object_bucket_sync.site", "files.js/app.js"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "added_count", "1"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "changed_count", "1"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "removed_count", "1"),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccScalewayObjectBucketSync_DeleteExtraneousOnCreate(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-bucket-sync")
+
+	sourceDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(sourceDir, "index.html"), []byte("<html></html>"), 0o600))
+
+	bucketConfig := fmt.Sprintf(`
+		resource "scaleway_object_bucket" "main" {
+			name = "%s"
+		}
+	`, bucketName)
+	syncConfig := bucketConfig + fmt.Sprintf(`
+		resource "scaleway_object_bucket_sync" "site" {
+			bucket            = scaleway_object_bucket.main.name
+			source_dir        = "%s"
+			exclude           = ["*.tmp"]
+			delete_extraneous = true
+		}
+	`, filepath.ToSlash(sourceDir))
+
+	putObject := func(key string) {
+		s3Client, err := newS3ClientFromMeta(tt.Meta)
+		require.NoError(t, err)
+		_, err = s3Client.PutObject(&s3.PutObjectInput{
+			Bucket: scw.StringPtr(bucketName),
+			Key:    scw.StringPtr(key),
+			Body:   strings.NewReader("stale"),
+		})
+		require.NoError(t, err)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectBucketDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: bucketConfig,
+			},
+			{
+				PreConfig: func() {
+					putObject("stale.html")
+					putObject("keep.tmp")
+				},
+				Config: syncConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "files.%", "1"),
+					resource.TestCheckResourceAttrSet("scaleway_object_bucket_sync.site", "files.index.html"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "added_count", "1"),
+					resource.TestCheckResourceAttr("scaleway_object_bucket_sync.site", "removed_count", "1"),
+					testAccCheckScalewayObjectBucketSyncObjectExists(tt, bucketName, "stale.html", false),
+					testAccCheckScalewayObjectBucketSyncObjectExists(tt, bucketName, "keep.tmp", true),
+				),
+			},
+			{
+				Config:   syncConfig,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckScalewayObjectBucketSyncObjectExists(tt *TestTools, bucket string, key string, exists bool) resource.TestCheckFunc {
+	return func(state *terraform.State) error {
+		s3Client, err := newS3ClientFromMeta(tt.Meta)
+		if err != nil {
+			return err
+		}
+
+		_, err = s3Client.HeadObject(&s3.HeadObjectInput{
+			Bucket: scw.StringPtr(bucket),
+			Key:    scw.StringPtr(key),
+		})
+		switch {
+		case err == nil && !exists:
+			return fmt.Errorf("object %s should have been deleted", key)
+		case err != nil && (exists || !isS3Err(err, "NotFound", "")):
+			return fmt.Errorf("failed to get object %s: %w", key, err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,174 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayObjectBucketVersioning() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayObjectBucketVersioningCreate,
+		ReadContext:   resourceScalewayObjectBucketVersioningRead,
+		UpdateContext: resourceScalewayObjectBucketVersioningUpdate,
+		DeleteContext: resourceScalewayObjectBucketVersioningDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Default: schema.DefaultTimeout(defaultObjectBucketTimeout),
+		},
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The bucket name.",
+			},
+			"versioning": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Allow multiple versions of an object in the same bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable versioning. Once you version-enable a bucket, it can never return to an unversioned state",
+						},
+					},
+				},
+			},
+			"region":     regionSchema(),
+			"project_id": projectIDSchema(),
+		},
+	}
+}
+
+func resourceScalewayObjectBucketVersioningCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, err := s3ClientWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	bucket := expandID(d.Get("bucket"))
+	versioningConfiguration := expandObjectBucketVersioning(d.Get("versioning").([]interface{}))
+
+	existing, err := s3Client.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// Buckets that were never versioned have no status
+	if aws.StringValue(existing.Status) != "" {
+		return diag.FromErr(objectBucketInlineConflictError(bucket, "versioning", "versioning"))
+	}
+
+	if err := resourceScalewayObjectBucketVersioningPut(ctx, s3Client, bucket, versioningConfiguration); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(newRegionalIDString(region, bucket))
+
+	return resourceScalewayObjectBucketVersioningRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketVersioningRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, region, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	versioningResponse, err := s3Client.GetBucketVersioningWithContext(ctx, &s3.GetBucketVersioningInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if !d.IsNewResource() && isS3Err(err, s3.ErrCodeNoSuchBucket, "") {
+		tflog.Warn(ctx, fmt.Sprintf("[WARN] SCW Bucket (%s) not found, removing versioning from state", d.Id()))
+		d.SetId("")
+		return nil
+	}
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_ = d.Set("bucket", bucket)
+	_ = d.Set("region", region)
+	_ = d.Set("versioning", flattenObjectBucketVersioning(versioningResponse))
+
+	acl, err := s3Client.GetBucketAclWithContext(ctx, &s3.GetBucketAclInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("couldn't read bucket acl: %s", err))
+	}
+	_ = d.Set("project_id", normalizeOwnerID(acl.Owner.ID))
+
+	return nil
+}
+
+func resourceScalewayObjectBucketVersioningUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("versioning") {
+		versioningConfiguration := expandObjectBucketVersioning(d.Get("versioning").([]interface{}))
+		if err := resourceScalewayObjectBucketVersioningPut(ctx, s3Client, bucket, versioningConfiguration); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceScalewayObjectBucketVersioningRead(ctx, d, meta)
+}
+
+func resourceScalewayObjectBucketVersioningDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	s3Client, _, bucket, err := s3ClientWithRegionAndName(d, meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Versioning can only be suspended, and must stay enabled on buckets with object lock
+	objectLockConfiguration, err := s3Client.GetObjectLockConfigurationWithContext(ctx, &s3.GetObjectLockConfigurationInput{
+		Bucket: scw.StringPtr(bucket),
+	})
+	switch {
+	case isS3Err(err, s3.ErrCodeNoSuchBucket, ""):
+		return nil
+	case err != nil && !isS3Err(err, ErrCodeObjectLockConfigurationNotFoundError, ""):
+		return diag.FromErr(fmt.Errorf("couldn't read bucket object lock configuration: %s", err))
+	case err == nil && objectLockConfiguration.ObjectLockConfiguration != nil:
+		tflog.Warn(ctx, fmt.Sprintf("bucket %s has object lock enabled, versioning is left enabled", bucket))
+		return nil
+	}
+
+	err = resourceScalewayObjectBucketVersioningPut(ctx, s3Client, bucket, expandObjectBucketVersioning(nil))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceScalewayObjectBucketVersioningPut(ctx context.Context, s3Client *s3.S3, bucket string, versioningConfiguration *s3.VersioningConfiguration) error {
+	input := &s3.PutBucketVersioningInput{
+		Bucket:                  scw.StringPtr(bucket),
+		VersioningConfiguration: versioningConfiguration,
+	}
+	tflog.Debug(ctx, fmt.Sprintf("S3 put bucket versioning: %#v", input))
+
+	_, err := s3Client.PutBucketVersioningWithContext(ctx, input)
+	if err != nil {
+		return fmt.Errorf("error putting S3 versioning: %s", err)
+	}
+
+	return nil
+}
@@ -1,9 +1,13 @@
 package scaleway
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
+	"os"
+	"path/filepath"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -12,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/scw"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAccScalewayObject_Basic(t *testing.T) {
@@ -601,6 +606,139 @@ func TestAccScalewayObject_ByContentBase64(t *testing.T) {
 	})
 }
 
+func TestAccScalewayObject_Multipart(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-multipart")
+
+	filePath := filepath.Join(t.TempDir(), "large.bin")
+	require.NoError(t, os.WriteFile(filePath, bytes.Repeat([]byte("scaleway"), 11*1024*1024/8), 0o600))
+
+	config := fmt.Sprintf(`
+		resource "scaleway_object_bucket" "base-01" {
+			name = "%s"
+		}
+
+		resource scaleway_object "file" {
+			bucket = scaleway_object_bucket.base-01.name
+			key = "large.bin"
+			file = "%s"
+			multipart_threshold = 5242880
+		}
+	`, bucketName, filepath.ToSlash(filePath))
+
+	checkMultipartETag := func(state *terraform.State) error {
+		s3Client, err := newS3ClientFromMeta(tt.Meta)
+		if err != nil {
+			return err
+		}
+
+		obj, err := s3Client.HeadObject(&s3.HeadObjectInput{
+			Bucket: scw.StringPtr(bucketName),
+			Key:    scw.StringPtr("large.bin"),
+		})
+		if err != nil {
+			return err
+		}
+		if !strings.HasSuffix(strings.Trim(*obj.ETag, `"`), "-3") {
+			return fmt.Errorf("object should have been uploaded in 3 parts, got ETag %s", *obj.ETag)
+		}
+
+		return nil
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayObjectExists(tt, "scaleway_object.file"),
+					checkMultipartETag,
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+			{
+				PreConfig: func() {
+					s3Client, err := newS3ClientFromMeta(tt.Meta)
+					require.NoError(t, err)
+					_, err = s3Client.PutObject(&s3.PutObjectInput{
+						Bucket: scw.StringPtr(bucketName),
+						Key:    scw.StringPtr("large.bin"),
+						Body:   strings.NewReader("modified outside terraform"),
+					})
+					require.NoError(t, err)
+				},
+				Config:             config,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: config,
+				Check:  checkMultipartETag,
+			},
+		},
+	})
+}
+
+func TestAccScalewayObject_SSECustomerKey(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping ObjectStorage test as this kind of resource can't be deleted before 24h")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-scaleway-object-sse-c")
+	config := fmt.Sprintf(`
+		resource "scaleway_object_bucket" "base-01" {
+			name = "%s"
+		}
+
+		resource scaleway_object "file" {
+			bucket = scaleway_object_bucket.base-01.name
+			key = "secret"
+			content = "Hello World"
+			sse_customer_key = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+			metadata = {
+				key = "value"
+			}
+		}
+
+		data scaleway_object "file" {
+			bucket = scaleway_object.file.bucket
+			key = scaleway_object.file.key
+			sse_customer_key = "MDEyMzQ1Njc4OWFiY2RlZjAxMjM0NTY3ODlhYmNkZWY="
+		}
+	`, bucketName)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayObjectDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("scaleway_object.file", "sse_customer_key", "hRasmdxgYDKV3nvbahU1MA=="),
+					resource.TestCheckResourceAttr("scaleway_object.file", "metadata.key", "value"),
+					resource.TestCheckResourceAttr("data.scaleway_object.file", "content_base64", base64.StdEncoding.EncodeToString([]byte("Hello World"))),
+				),
+			},
+			{
+				Config:   config,
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckScalewayObjectExists(tt *TestTools, n string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		rs := state.RootModule().Resources[n]
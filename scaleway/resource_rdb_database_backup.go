@@ -73,6 +73,36 @@ func resourceScalewayRdbDatabaseBackup() *schema.Resource {
 				Description: "Updated date (Format ISO 8601).",
 				Computed:    true,
 			},
+			"export_to_bucket": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Export the backup to an object storage bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the bucket to export the backup to, in the backup region",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The prefix of the exported object key",
+						},
+						"object_key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The key of the exported object",
+						},
+						"object_size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the exported object (in bytes)",
+						},
+					},
+				},
+			},
 			// Common
 			"region": regionSchema(),
 		},
@@ -108,6 +138,13 @@ func resourceScalewayRdbDatabaseBackupCreate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	if _, ok := d.GetOk("export_to_bucket"); ok {
+		err = rdbDatabaseBackupExportToBucket(ctx, d, meta, rdbAPI, region, dbBackup.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayRdbDatabaseBackupRead(ctx, d, meta)
 }
 
@@ -175,6 +212,13 @@ func resourceScalewayRdbDatabaseBackupUpdate(ctx context.Context, d *schema.Reso
 		return diag.FromErr(err)
 	}
 
+	if _, ok := d.GetOk("export_to_bucket"); ok && d.HasChanges("export_to_bucket.0.bucket", "export_to_bucket.0.prefix") {
+		err = rdbDatabaseBackupExportToBucket(ctx, d, meta, rdbAPI, region, id, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayRdbDatabaseBackupRead(ctx, d, meta)
 }
 
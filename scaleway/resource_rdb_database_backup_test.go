@@ -2,8 +2,10 @@ package scaleway
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	sdkacctest "github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
@@ -104,6 +106,62 @@ func TestAccScalewayRdbDatabaseBackup_Basic(t *testing.T) {
 	})
 }
 
+func TestAccScalewayRdbDatabaseBackup_ExportToBucket(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	bucketName := sdkacctest.RandomWithPrefix("test-acc-rdb-backup-export")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayRdbInstanceDestroy(tt),
+			testAccCheckScalewayObjectBucketDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource scaleway_rdb_instance main {
+						name = "TestAccScalewayRdbDatabaseBackup_ExportToBucket"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+					}
+
+					resource scaleway_rdb_database main {
+						instance_id = scaleway_rdb_instance.main.id
+						name = "foo"
+					}
+
+					resource scaleway_object_bucket main {
+						name = %q
+						force_destroy = true
+					}
+
+					resource scaleway_rdb_database_backup main {
+						instance_id = scaleway_rdb_instance.main.id
+						database_name = scaleway_rdb_database.main.name
+						name = "test_backup_export"
+						export_to_bucket {
+							bucket = scaleway_object_bucket.main.name
+							prefix = "backups/"
+						}
+					}`, bucketName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdbDatabaseBackupExists(tt, "scaleway_rdb_database_backup.main"),
+					resource.TestCheckResourceAttr("scaleway_rdb_database_backup.main", "export_to_bucket.0.bucket", bucketName),
+					resource.TestMatchResourceAttr("scaleway_rdb_database_backup.main", "export_to_bucket.0.object_key", regexp.MustCompile("^backups/.+")),
+					resource.TestCheckResourceAttrSet("scaleway_rdb_database_backup.main", "export_to_bucket.0.object_size"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckScalewayRdbDatabaseBackupDestroy(tt *TestTools) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		for _, rs := range state.RootModule().Resources {
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
@@ -185,6 +186,41 @@ func resourceScalewayRdbInstance() *schema.Resource {
 					},
 				},
 			},
+			"restore_from": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "Restore the database instance from an existing backup or instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"backup_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validationUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: diffSuppressFuncLocality,
+							ExactlyOneOf:     []string{"restore_from.0.backup_id", "restore_from.0.source_instance_id"},
+							Description:      "The ID of the database backup to restore into the new instance",
+						},
+						"database_name": {
+							Type:          schema.TypeString,
+							Optional:      true,
+							ForceNew:      true,
+							ConflictsWith: []string{"restore_from.0.source_instance_id"},
+							Description:   "The name of the database to restore the backup into, defaults to the backup's database name",
+						},
+						"source_instance_id": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							ValidateFunc:     validationUUIDorUUIDWithLocality(),
+							DiffSuppressFunc: diffSuppressFuncLocality,
+							Description:      "The ID of the database instance to clone",
+						},
+					},
+				},
+			},
 			// Computed
 			"endpoint_ip": {
 				Type:        schema.TypeString,
@@ -267,7 +303,11 @@ func resourceScalewayRdbInstance() *schema.Resource {
 			"organization_id": organizationIDSchema(),
 			"project_id":      projectIDSchema(),
 		},
-		CustomizeDiff: customizeDiffLocalityCheck("private_network.#.pn_id"),
+		CustomizeDiff: customdiff.All(
+			customizeDiffLocalityCheck("private_network.#.pn_id"),
+			resourceScalewayRdbInstanceCustomizeDiffSettings,
+			resourceScalewayRdbInstanceCustomizeDiffClone,
+		),
 	}
 }
 
@@ -316,12 +356,48 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 		createReq.VolumeSize = scw.Size(uint64(size.(int)) * uint64(scw.GB))
 	}
 
-	res, err := rdbAPI.CreateInstance(createReq, scw.WithContext(ctx))
-	if err != nil {
-		return diag.FromErr(err)
-	}
+	var res *rdb.Instance
+	if sourceInstanceID, ok := d.GetOk("restore_from.0.source_instance_id"); ok {
+		// The source instance may not have been known at plan time
+		source, err := rdbAPI.GetInstance(&rdb.GetInstanceRequest{
+			Region:     region,
+			InstanceID: expandID(sourceInstanceID),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 
-	d.SetId(newRegionalIDString(region, res.ID))
+		err = validateRdbInstanceCloneSource(source, func(key string) (interface{}, bool) {
+			return d.Get(key), true
+		})
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		res, err = rdbAPI.CloneInstance(&rdb.CloneInstanceRequest{
+			Region:     region,
+			InstanceID: expandID(sourceInstanceID),
+			Name:       createReq.Name,
+			NodeType:   scw.StringPtr(createReq.NodeType),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(newRegionalIDString(region, res.ID))
+
+		err = rdbInstanceConfigureClone(ctx, d, rdbAPI, region, res.ID, createReq)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	} else {
+		res, err = rdbAPI.CreateInstance(createReq, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		d.SetId(newRegionalIDString(region, res.ID))
+	}
 
 	// Configure Schedule Backup
 	// BackupScheduleFrequency and BackupScheduleRetention can only configure after instance creation
@@ -367,6 +443,28 @@ func resourceScalewayRdbInstanceCreate(ctx context.Context, d *schema.ResourceDa
 			return diag.FromErr(err)
 		}
 	}
+	// Restore the backup into the freshly created instance
+	if backupID, ok := d.GetOk("restore_from.0.backup_id"); ok {
+		_, err = waitForRDBInstance(ctx, rdbAPI, region, res.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		backup, err := rdbAPI.RestoreDatabaseBackup(&rdb.RestoreDatabaseBackupRequest{
+			Region:           region,
+			DatabaseBackupID: expandID(backupID),
+			DatabaseName:     expandStringPtr(d.Get("restore_from.0.database_name")),
+			InstanceID:       res.ID,
+		}, scw.WithContext(ctx))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		_, err = waitForRDBDatabaseBackup(ctx, rdbAPI, region, backup.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
 
 	return resourceScalewayRdbInstanceRead(ctx, d, meta)
 }
@@ -605,6 +703,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 	////////////////////
 	// Change settings
 	////////////////////
+	var diags diag.Diagnostics
 	if d.HasChange("settings") {
 		_, err = waitForRDBInstance(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
@@ -618,6 +717,14 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		if err != nil {
 			return diag.FromErr(err)
 		}
+
+		version, err := rdbEngineVersion(ctx, rdbAPI, region, d.Get("engine").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if version != nil {
+			diags = append(diags, rdbSettingsRestartWarnings(rdbChangedSettings(d.GetChange("settings")), version.AvailableSettings)...)
+		}
 	}
 
 	////////////////////
@@ -689,7 +796,7 @@ func resourceScalewayRdbInstanceUpdate(ctx context.Context, d *schema.ResourceDa
 		}
 	}
 
-	return resourceScalewayRdbInstanceRead(ctx, d, meta)
+	return append(diags, resourceScalewayRdbInstanceRead(ctx, d, meta)...)
 }
 
 func resourceScalewayRdbInstanceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
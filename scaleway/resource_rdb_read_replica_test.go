@@ -52,6 +52,99 @@ func TestAccScalewayRdbReadReplica_Basic(t *testing.T) {
 	})
 }
 
+func TestAccScalewayRdbReadReplica_Promote(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckScalewayRdbInstanceDestroy(tt),
+			testAccCheckScalewayRdbReadReplicaDestroy(tt),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_rdb_instance instance {
+						name = "test-rdb-rr-promote"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+						tags = [ "terraform-test", "scaleway_rdb_read_replica", "promote" ]
+					}
+
+					resource "scaleway_rdb_read_replica" "replica" {
+						instance_id = scaleway_rdb_instance.instance.id
+						direct_access {}
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdbReadReplicaExists(tt, "scaleway_rdb_read_replica.replica"),
+					resource.TestCheckResourceAttr("scaleway_rdb_read_replica.replica", "promoted_instance_id", ""),
+				),
+			},
+			{
+				Config: `
+					resource scaleway_rdb_instance instance {
+						name = "test-rdb-rr-promote"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+						tags = [ "terraform-test", "scaleway_rdb_read_replica", "promote" ]
+					}
+
+					resource "scaleway_rdb_read_replica" "replica" {
+						instance_id = scaleway_rdb_instance.instance.id
+						direct_access {}
+						promote = true
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("scaleway_rdb_read_replica.replica", "promoted_instance_id"),
+				),
+			},
+			{
+				Config: `
+					resource scaleway_rdb_instance instance {
+						name = "test-rdb-rr-promote"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						disable_backup = true
+						user_name = "my_initial_user"
+						password = "thiZ_is_v&ry_s3cret"
+						tags = [ "terraform-test", "scaleway_rdb_read_replica", "promote" ]
+					}
+
+					resource "scaleway_rdb_read_replica" "replica" {
+						instance_id = scaleway_rdb_instance.instance.id
+						direct_access {}
+						promote = true
+					}
+
+					resource scaleway_rdb_instance promoted {
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+					}`,
+				ResourceName: "scaleway_rdb_instance.promoted",
+				ImportState:  true,
+				ImportStateIdFunc: func(state *terraform.State) (string, error) {
+					return state.RootModule().Resources["scaleway_rdb_read_replica.replica"].Primary.Attributes["promoted_instance_id"], nil
+				},
+				ImportStatePersist: true,
+			},
+		},
+	})
+}
+
 func TestAccScalewayRdbReadReplica_PrivateNetwork(t *testing.T) {
 	tt := NewTestTools(t)
 	defer tt.Cleanup()
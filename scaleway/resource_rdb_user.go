@@ -4,11 +4,14 @@ import (
 	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/rdb/v1"
 	"github.com/scaleway/scaleway-sdk-go/scw"
 )
@@ -45,10 +48,41 @@ func resourceScalewayRdbUser() *schema.Resource {
 				ForceNew:    true,
 			},
 			"password": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"generate_password"},
+				Description:   "Database user password",
+			},
+			"generate_password": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Generate the database user password and store it in password_secret_id instead of the state",
+			},
+			"password_secret_id": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateFunc:     validationUUIDorUUIDWithLocality(),
+				DiffSuppressFunc: diffSuppressFuncLocality,
+				RequiredWith:     []string{"generate_password"},
+				Description:      "The secret the generated password is written to",
+			},
+			"rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"generate_password"},
+				Description:  "Number of days after which the generated password is rotated on the next apply",
+			},
+			"password_secret_revision": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The revision of the secret version holding the generated password",
+			},
+			"password_rotated_at": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Sensitive:   true,
-				Description: "Database user password",
+				Computed:    true,
+				Description: "Date and time of the last password generation (RFC 3339 format)",
 			},
 			"is_admin": {
 				Type:        schema.TypeBool,
@@ -58,7 +92,10 @@ func resourceScalewayRdbUser() *schema.Resource {
 			// Common
 			"region": regionSchema(),
 		},
-		CustomizeDiff: customizeDiffLocalityCheck("instance_id"),
+		CustomizeDiff: customdiff.All(
+			customizeDiffLocalityCheck("instance_id"),
+			resourceScalewayRdbUserCustomizeDiff,
+		),
 	}
 }
 
@@ -84,6 +121,16 @@ func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData,
 		IsAdmin:    d.Get("is_admin").(bool),
 	}
 
+	var passwordVersion *rdbUserPasswordVersion
+	if d.Get("generate_password").(bool) {
+		// the password is stored before the user is created so that it is never lost
+		passwordVersion, err = rdbUserCreatePasswordVersion(ctx, d, meta, region)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		createReq.Password = passwordVersion.password
+	}
+
 	var user *rdb.User
 	//  wrapper around StateChangeConf that will just retry write on database
 	err = resource.RetryContext(ctx, d.Timeout(schema.TimeoutCreate), func() *resource.RetryError {
@@ -103,12 +150,22 @@ func resourceScalewayRdbUserCreate(ctx context.Context, d *schema.ResourceData,
 		return nil
 	})
 	if err != nil {
+		if passwordVersion != nil {
+			err = rdbUserRollbackPasswordVersion(ctx, passwordVersion, err)
+		}
 		return diag.FromErr(err)
 	}
 
 	d.SetId(resourceScalewayRdbUserID(region, expandID(instanceID), user.Name))
 
-	return resourceScalewayRdbUserRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if passwordVersion != nil {
+		if err := rdbUserCommitPasswordVersion(ctx, d, passwordVersion); err != nil {
+			diags = rdbUserPasswordCommitWarning(err)
+		}
+	}
+
+	return append(diags, resourceScalewayRdbUserRead(ctx, d, meta)...)
 }
 
 func resourceScalewayRdbUserRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -178,16 +235,34 @@ func resourceScalewayRdbUserUpdate(ctx context.Context, d *schema.ResourceData,
 	if d.HasChange("password") {
 		req.Password = expandStringPtr(d.Get("password"))
 	}
+	var passwordVersion *rdbUserPasswordVersion
+	if d.Get("generate_password").(bool) && d.HasChanges("generate_password", "password_secret_id", "password_rotated_at") {
+		passwordVersion, err = rdbUserCreatePasswordVersion(ctx, d, meta, region)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		req.Password = &passwordVersion.password
+	}
 	if d.HasChange("is_admin") {
 		req.IsAdmin = scw.BoolPtr(d.Get("is_admin").(bool))
 	}
 
 	_, err = rdbAPI.UpdateUser(req, scw.WithContext(ctx))
 	if err != nil {
+		if passwordVersion != nil {
+			err = rdbUserRollbackPasswordVersion(ctx, passwordVersion, err)
+		}
 		return diag.FromErr(err)
 	}
 
-	return resourceScalewayRdbUserRead(ctx, d, meta)
+	var diags diag.Diagnostics
+	if passwordVersion != nil {
+		if err := rdbUserCommitPasswordVersion(ctx, d, passwordVersion); err != nil {
+			diags = rdbUserPasswordCommitWarning(err)
+		}
+	}
+
+	return append(diags, resourceScalewayRdbUserRead(ctx, d, meta)...)
 }
 
 func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -230,6 +305,37 @@ func resourceScalewayRdbUserDelete(ctx context.Context, d *schema.ResourceData,
 	return nil
 }
 
+func resourceScalewayRdbUserCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+	if !diff.Get("generate_password").(bool) {
+		if diff.NewValueKnown("password") && diff.Get("password").(string) == "" {
+			return fmt.Errorf("one of password or generate_password must be set")
+		}
+		return nil
+	}
+
+	if diff.NewValueKnown("password_secret_id") && diff.Get("password_secret_id").(string) == "" {
+		return fmt.Errorf("password_secret_id must be set when generate_password is enabled")
+	}
+
+	// a new password is generated on creation, when enabling the generation or changing the secret
+	rotate := diff.Id() == "" || diff.HasChanges("generate_password", "password_secret_id")
+
+	rotationDays := diff.Get("rotation_days").(int)
+	if rotationDays > 0 {
+		rotatedAt := expandTimePtr(diff.Get("password_rotated_at"))
+		rotate = rotate || rotatedAt == nil || time.Now().After(rotatedAt.AddDate(0, 0, rotationDays))
+	}
+
+	if rotate {
+		if err := diff.SetNewComputed("password_rotated_at"); err != nil {
+			return err
+		}
+		return diff.SetNewComputed("password_secret_revision")
+	}
+
+	return nil
+}
+
 // Build the resource identifier
 // The resource identifier format is "Region/InstanceId/UserName"
 func resourceScalewayRdbUserID(region scw.Region, instanceID string, userName string) (resourceID string) {
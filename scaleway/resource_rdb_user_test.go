@@ -69,6 +69,50 @@ func TestAccScalewayRdbUser_Basic(t *testing.T) {
 	})
 }
 
+func TestAccScalewayRdbUser_GeneratePassword(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayRdbInstanceDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource scaleway_rdb_instance main {
+						name = "TestAccScalewayRdbUser_GeneratePassword"
+						node_type = "db-dev-s"
+						engine = "PostgreSQL-15"
+						is_ha_cluster = false
+						tags = [ "terraform-test", "scaleway_rdb_user", "generate_password" ]
+					}
+
+					resource scaleway_secret main {
+						name = "TestAccScalewayRdbUser_GeneratePassword"
+					}
+
+					resource scaleway_rdb_user db_user {
+						instance_id = scaleway_rdb_instance.main.id
+						name = "foo"
+						generate_password = true
+						password_secret_id = scaleway_secret.main.id
+						rotation_days = 30
+					}`,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRdbUserExists(tt, "scaleway_rdb_instance.main", "scaleway_rdb_user.db_user"),
+					resource.TestCheckResourceAttr("scaleway_rdb_user.db_user", "password", ""),
+					resource.TestCheckResourceAttr("scaleway_rdb_user.db_user", "password_secret_revision", "1"),
+					resource.TestCheckResourceAttrSet("scaleway_rdb_user.db_user", "password_rotated_at"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckRdbUserExists(tt *TestTools, instance string, user string) resource.TestCheckFunc {
 	return func(state *terraform.State) error {
 		instanceResource, ok := state.RootModule().Resources[instance]
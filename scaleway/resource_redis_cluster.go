@@ -7,7 +7,9 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/scaleway/scaleway-sdk-go/api/redis/v1"
@@ -203,7 +205,10 @@ func resourceScalewayRedisCluster() *schema.Resource {
 			"zone":       zoneSchema(),
 			"project_id": projectIDSchema(),
 		},
-		CustomizeDiff: customizeDiffLocalityCheck("private_network.#.id"),
+		CustomizeDiff: customdiff.All(
+			customizeDiffLocalityCheck("private_network.#.id"),
+			customizeDiffRedisClusterSize,
+		),
 	}
 }
 
@@ -406,18 +411,28 @@ func resourceScalewayRedisClusterUpdate(ctx context.Context, d *schema.ResourceD
 		})
 	}
 	for i := range migrateClusterRequests {
+		migration := redisClusterMigrationDescription(&migrateClusterRequests[i])
+
 		_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
 			return diag.FromErr(err)
 		}
+
+		tflog.Info(ctx, fmt.Sprintf("redis cluster %s: %s", ID, migration))
 		_, err = redisAPI.MigrateCluster(&migrateClusterRequests[i], scw.WithContext(ctx))
 		if err != nil {
-			return diag.FromErr(err)
+			return diag.FromErr(fmt.Errorf("failed to %s: %w", migration, err))
 		}
 
-		_, err = waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
+		cluster, err := waitForRedisCluster(ctx, redisAPI, zone, ID, d.Timeout(schema.TimeoutUpdate))
 		if err != nil && !is404Error(err) {
-			return diag.FromErr(err)
+			return diag.FromErr(fmt.Errorf("failed to wait for the cluster to %s: %w", migration, err))
+		}
+		if cluster != nil {
+			if cluster.Status == redis.ClusterStatusError {
+				return diag.FromErr(fmt.Errorf("cluster is in %s status after trying to %s", cluster.Status, migration))
+			}
+			tflog.Info(ctx, fmt.Sprintf("redis cluster %s: done, cluster is %s", ID, cluster.Status))
 		}
 	}
 
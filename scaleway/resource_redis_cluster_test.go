@@ -4,6 +4,7 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -176,6 +177,70 @@ func TestAccScalewayRedisCluster_Migrate(t *testing.T) {
 	})
 }
 
+func TestAccScalewayRedisCluster_ScaleOut(t *testing.T) {
+	if !*UpdateCassettes {
+		t.Skip("Skipping until the cassette of this test is recorded")
+	}
+	tt := NewTestTools(t)
+	defer tt.Cleanup()
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: tt.ProviderFactories,
+		CheckDestroy:      testAccCheckScalewayRedisClusterDestroy(tt),
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "scaleway_redis_cluster" "main" {
+				  name         = "test_redis_scale_out"
+				  version      = "7.0.12"
+				  node_type    = "RED1-XS"
+				  user_name    = "my_initial_user"
+				  password     = "thiZ_is_v&ry_s3cret"
+				  cluster_size = %d
+				  tls_enabled  = "true"
+				}
+				`, 3),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayRedisExists(tt, "scaleway_redis_cluster.main"),
+					resource.TestCheckResourceAttr("scaleway_redis_cluster.main", "cluster_size", "3"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "scaleway_redis_cluster" "main" {
+				  name         = "test_redis_scale_out"
+				  version      = "7.0.12"
+				  node_type    = "RED1-XS"
+				  user_name    = "my_initial_user"
+				  password     = "thiZ_is_v&ry_s3cret"
+				  cluster_size = %d
+				  tls_enabled  = "true"
+				}
+				`, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScalewayRedisExists(tt, "scaleway_redis_cluster.main"),
+					resource.TestCheckResourceAttr("scaleway_redis_cluster.main", "cluster_size", "4"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "scaleway_redis_cluster" "main" {
+				  name         = "test_redis_scale_out"
+				  version      = "7.0.12"
+				  node_type    = "RED1-XS"
+				  user_name    = "my_initial_user"
+				  password     = "thiZ_is_v&ry_s3cret"
+				  cluster_size = %d
+				  tls_enabled  = "true"
+				}
+				`, 3),
+				PlanOnly:    true,
+				ExpectError: regexp.MustCompile("cluster_size cannot be reduced from 4 to 3"),
+			},
+		},
+	})
+}
+
 func TestAccScalewayRedisCluster_ACL(t *testing.T) {
 	tt := NewTestTools(t)
 	defer tt.Cleanup()